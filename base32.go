@@ -0,0 +1,146 @@
+package guid
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"unsafe"
+)
+
+const (
+	GuidBase32ByteSize      = 26                                 // Crockford Base32 encoding of a Guid is 26 characters
+	base32CrockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ" // Crockford Base32 alphabet (no I, L, O, U), in ASCII order
+)
+
+var (
+	// ErrInvalidBase32GuidEncoding is returned when a string does not represent a valid Crockford Base32 Guid.
+	ErrInvalidBase32GuidEncoding = errors.New("invalid Crockford Base32 Guid encoding (invalid characters, or length != 26 without hyphens)")
+	// ErrBufferTooSmallBase32 is returned when a destination slice is too small to receive the Crockford Base32 Guid.
+	ErrBufferTooSmallBase32 = fmt.Errorf("buffer is too small (length < %d bytes)", GuidBase32ByteSize)
+)
+
+//==============================================
+// Guid Extension Methods
+//==============================================
+
+// Base32String returns the 26-char uppercase Crockford Base32 representation of the Guid.
+// The 128 bits are encoded big-endian (the first char holds the top 3 bits, so it is 0-7),
+// so the strings of GuidPG values sort lexically in creation order (ULID-compatible text).
+func (guid *Guid) Base32String() string {
+	buffer := make([]byte, GuidBase32ByteSize)
+	guid.encodeBase32(buffer)
+	return unsafe.String(&buffer[0], GuidBase32ByteSize) // same approach as String()
+}
+
+// EncodeBase32 encodes the Guid into the provided dst as 26-char uppercase Crockford Base32.
+func (guid *Guid) EncodeBase32(dst []byte) error {
+	if len(dst) < GuidBase32ByteSize {
+		return ErrBufferTooSmallBase32
+	}
+	guid.encodeBase32(dst)
+	return nil
+}
+
+// private - panics on undersized buffer or nil guid
+func (guid *Guid) encodeBase32(dst []byte) {
+	// Bounds Check Elimination
+	_ = dst[GuidBase32ByteSize-1]
+
+	// 26 5-bit chars hold 130 bits: the 128-bit Guid with 2 leading zero bits.
+	hi, lo := guid.halves()
+	for i := GuidBase32ByteSize - 1; i >= 0; i-- {
+		dst[i] = base32CrockfordAlphabet[lo&0x1F]
+		hi, lo = shr128(hi, lo, 5)
+	}
+}
+
+//==============================================
+// Standalone Functions
+//==============================================
+
+// ParseBase32 parses a Crockford Base32 string into a Guid.
+// Decoding is case-insensitive, accepts I and L as 1 and O as 0, and ignores hyphens,
+// so "01ARZ3NDEK-TSV4RRFF-Q69G5FAV" and "01arz3ndektsv4rrffq69g5fav" are the same Guid.
+func ParseBase32(s string) (Guid, error) {
+	// Zero-copy conversion of a string to a byte slice
+	return ParseBase32Bytes(unsafe.Slice(unsafe.StringData(s), len(s)))
+}
+
+// ParseBase32Bytes parses a Crockford Base32 string represented as a byte slice into a Guid.
+// ParseBase32Bytes is like ParseBase32, except it parses a string byte slice instead of a string.
+func ParseBase32Bytes(src []byte) (g Guid, err error) {
+	if ok := DecodeBase32(g[:], src); !ok {
+		return Guid{}, ErrInvalidBase32GuidEncoding
+	}
+	return g, nil
+}
+
+// DecodeBase32 decodes a Crockford Base32 src byte slice into a Guid dst byte slice.
+// src must hold exactly 26 Crockford Base32 chars (case-insensitive; I and L read as 1, O as 0),
+// plus any number of hyphens, and the first char must be 0-7. Does not panic on invalid input.
+// dst must be at least 16 bytes long (returns false otherwise). dst is not modified if the function returns false.
+func DecodeBase32(dst []byte, src []byte) (ok bool) {
+	if len(dst) < GuidByteSize {
+		return false
+	}
+
+	var hi, lo uint64
+	n := 0 // number of decoded chars
+	for _, c := range src {
+		if c == '-' {
+			continue
+		}
+		v := decodeLookupBase32[c]
+		if v == 0xFF || n == GuidBase32ByteSize || (n == 0 && v > 7) {
+			return false // invalid char, too long, or more than 128 bits
+		}
+		hi, lo = shl128(hi, lo, 5)
+		lo |= uint64(v)
+		n++
+	}
+	if n != GuidBase32ByteSize {
+		return false
+	}
+
+	binary.BigEndian.PutUint64(dst[:8], hi)
+	binary.BigEndian.PutUint64(dst[8:GuidByteSize], lo)
+	return true
+}
+
+// decodeLookupBase32 is a lookup table for decoding Crockford Base32 characters (upper and lower case) to their values.
+// Generated the same way as decodeLookup, with i/I/l/L mapped to 1 and o/O mapped to 0.
+// Values outside the Crockford Base32 alphabet are marked with 0xFF.
+var decodeLookupBase32 = [256]byte{
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07,
+	0x08, 0x09, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10,
+	0x11, 0x01, 0x12, 0x13, 0x01, 0x14, 0x15, 0x00,
+	0x16, 0x17, 0x18, 0x19, 0x1A, 0xFF, 0x1B, 0x1C,
+	0x1D, 0x1E, 0x1F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10,
+	0x11, 0x01, 0x12, 0x13, 0x01, 0x14, 0x15, 0x00,
+	0x16, 0x17, 0x18, 0x19, 0x1A, 0xFF, 0x1B, 0x1C,
+	0x1D, 0x1E, 0x1F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+}
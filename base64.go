@@ -0,0 +1,128 @@
+package guid
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// ErrInvalidBase64GuidEncoding is returned when a standard, padded or either-alphabet Base64 string does not represent a valid Guid.
+var ErrInvalidBase64GuidEncoding = errors.New("invalid Base64 Guid encoding (invalid characters, or length != 22, or != 24 with \"==\" padding)")
+
+//==============================================
+// Standalone Functions
+//==============================================
+
+// ParseBase64Std parses a 22-char unpadded standard Base64 ("+/" alphabet, RFC 4648 section 4) string into a Guid.
+func ParseBase64Std(s string) (Guid, error) {
+	// Zero-copy conversion of a string to a byte slice
+	return parseBase64(unsafe.Slice(unsafe.StringData(s), len(s)), &decodeLookupStd, true, false)
+}
+
+// ParseBase64StdBytes is like ParseBase64Std, except it parses a string byte slice instead of a string.
+func ParseBase64StdBytes(src []byte) (Guid, error) {
+	return parseBase64(src, &decodeLookupStd, true, false)
+}
+
+// ParseBase64StdPadded parses a 24-char standard Base64 ("+/" alphabet) string with "==" padding into a Guid.
+func ParseBase64StdPadded(s string) (Guid, error) {
+	// Zero-copy conversion of a string to a byte slice
+	return parseBase64(unsafe.Slice(unsafe.StringData(s), len(s)), &decodeLookupStd, false, true)
+}
+
+// ParseBase64StdPaddedBytes is like ParseBase64StdPadded, except it parses a string byte slice instead of a string.
+func ParseBase64StdPaddedBytes(src []byte) (Guid, error) {
+	return parseBase64(src, &decodeLookupStd, false, true)
+}
+
+// ParseBase64URLPadded parses a 24-char Base64Url ("-_" alphabet) string with "==" padding into a Guid.
+// Parse accepts only the unpadded 22-char form.
+func ParseBase64URLPadded(s string) (Guid, error) {
+	// Zero-copy conversion of a string to a byte slice
+	return parseBase64(unsafe.Slice(unsafe.StringData(s), len(s)), &decodeLookup, false, true)
+}
+
+// ParseBase64URLPaddedBytes is like ParseBase64URLPadded, except it parses a string byte slice instead of a string.
+func ParseBase64URLPaddedBytes(src []byte) (Guid, error) {
+	return parseBase64(src, &decodeLookup, false, true)
+}
+
+// ParseBase64Any parses a Base64 string in either alphabet ("-_" Base64Url or "+/" standard),
+// unpadded (22 chars) or with "==" padding (24 chars), into a Guid.
+// Each of the two alphabet-specific characters is accepted anywhere, so mixed strings are accepted as well.
+func ParseBase64Any(s string) (Guid, error) {
+	// Zero-copy conversion of a string to a byte slice
+	return parseBase64(unsafe.Slice(unsafe.StringData(s), len(s)), &decodeLookupAny, true, true)
+}
+
+// ParseBase64AnyBytes is like ParseBase64Any, except it parses a string byte slice instead of a string.
+func ParseBase64AnyBytes(src []byte) (Guid, error) {
+	return parseBase64(src, &decodeLookupAny, true, true)
+}
+
+// DecodeBase64Std decodes a 22-char unpadded standard Base64 ("+/" alphabet) src byte slice into a Guid dst byte slice.
+// Does not panic on invalid input.
+// dst must be at least 16 bytes long and src must be at least 22 bytes long (returns false otherwise).
+// dst is modified even if the function returns false.
+func DecodeBase64Std(dst []byte, src []byte) (ok bool) {
+	return decodeBase64(dst, src, &decodeLookupStd)
+}
+
+// DecodeBase64Any decodes a 22-char unpadded Base64 src byte slice in either alphabet ("-_" or "+/") into a Guid dst byte slice.
+// Does not panic on invalid input.
+// dst must be at least 16 bytes long and src must be at least 22 bytes long (returns false otherwise).
+// dst is modified even if the function returns false.
+func DecodeBase64Any(dst []byte, src []byte) (ok bool) {
+	return decodeBase64(dst, src, &decodeLookupAny)
+}
+
+// private - decodes an unpadded (22-char) and/or "==" padded (24-char) src with the alphabet described by lookup.
+func parseBase64(src []byte, lookup *[256]byte, unpadded, padded bool) (g Guid, err error) {
+	switch n := len(src); {
+	case n == GuidBase64UrlByteSize && unpadded:
+	case n == GuidBase64UrlByteSize+2 && padded && src[GuidBase64UrlByteSize] == '=' && src[GuidBase64UrlByteSize+1] == '=':
+	default:
+		return Guid{}, ErrInvalidBase64GuidEncoding
+	}
+
+	if ok := decodeBase64(g[:], src, lookup); !ok {
+		return Guid{}, ErrInvalidBase64GuidEncoding
+	}
+	return g, nil
+}
+
+// decodeLookupAny is a lookup table for decoding both Base64Url ("-_") and standard Base64 ("+/") characters to their byte values.
+// Generated the same way as decodeLookup, from both alphabets. Values outside both alphabets are marked with 0xFF.
+var decodeLookupAny = [256]byte{
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x3E, 0xFF, 0x3E, 0xFF, 0x3F,
+	0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3A, 0x3B,
+	0x3C, 0x3D, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06,
+	0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E,
+	0x0F, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16,
+	0x17, 0x18, 0x19, 0xFF, 0xFF, 0xFF, 0xFF, 0x3F,
+	0xFF, 0x1A, 0x1B, 0x1C, 0x1D, 0x1E, 0x1F, 0x20,
+	0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+	0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F, 0x30,
+	0x31, 0x32, 0x33, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+}
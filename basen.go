@@ -0,0 +1,250 @@
+package guid
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"unsafe"
+)
+
+const (
+	GuidBase58ByteSize = 22                                                               // Base58 encoding of a Guid is 22 characters (zero-padded)
+	GuidBase62ByteSize = 22                                                               // Base62 encoding of a Guid is 22 characters (zero-padded)
+	base58Alphabet     = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"     // Bitcoin Base58 alphabet (no 0, I, O, l), in ASCII order
+	base62Alphabet     = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz" // Base62 alphabet, in ASCII order
+	baseNChunkDigits   = 10                                                               // digits per uint64 chunk: 58^10 and 62^10 fit into 64 bits
+)
+
+var (
+	// ErrInvalidBase58GuidEncoding is returned when a string does not represent a valid Base58 Guid.
+	ErrInvalidBase58GuidEncoding = errors.New("invalid Base58 Guid encoding (invalid characters, length != 22, or value > 128 bits)")
+	// ErrInvalidBase62GuidEncoding is returned when a string does not represent a valid Base62 Guid.
+	ErrInvalidBase62GuidEncoding = errors.New("invalid Base62 Guid encoding (invalid characters, length != 22, or value > 128 bits)")
+	// ErrBufferTooSmallBase58 is returned when a destination slice is too small to receive the Base58 Guid.
+	ErrBufferTooSmallBase58 = fmt.Errorf("buffer is too small (length < %d bytes)", GuidBase58ByteSize)
+	// ErrBufferTooSmallBase62 is returned when a destination slice is too small to receive the Base62 Guid.
+	ErrBufferTooSmallBase62 = fmt.Errorf("buffer is too small (length < %d bytes)", GuidBase62ByteSize)
+)
+
+//==============================================
+// Guid Extension Methods
+//==============================================
+
+// Base58String returns the 22-char Base58 (Bitcoin alphabet) representation of the Guid, left-padded with '1' (zero).
+// The string is alphanumeric only, and the strings of GuidPG values sort lexically in creation order.
+func (guid *Guid) Base58String() string {
+	buffer := make([]byte, GuidBase58ByteSize)
+	guid.encodeBaseN(buffer, base58Alphabet)
+	return unsafe.String(&buffer[0], GuidBase58ByteSize) // same approach as String()
+}
+
+// EncodeBase58 encodes the Guid into the provided dst as 22-char Base58 (Bitcoin alphabet).
+func (guid *Guid) EncodeBase58(dst []byte) error {
+	if len(dst) < GuidBase58ByteSize {
+		return ErrBufferTooSmallBase58
+	}
+	guid.encodeBaseN(dst, base58Alphabet)
+	return nil
+}
+
+// Base62String returns the 22-char Base62 (0-9A-Za-z) representation of the Guid, left-padded with '0'.
+// The string is alphanumeric only, and the strings of GuidPG values sort lexically in creation order.
+func (guid *Guid) Base62String() string {
+	buffer := make([]byte, GuidBase62ByteSize)
+	guid.encodeBaseN(buffer, base62Alphabet)
+	return unsafe.String(&buffer[0], GuidBase62ByteSize) // same approach as String()
+}
+
+// EncodeBase62 encodes the Guid into the provided dst as 22-char Base62 (0-9A-Za-z).
+func (guid *Guid) EncodeBase62(dst []byte) error {
+	if len(dst) < GuidBase62ByteSize {
+		return ErrBufferTooSmallBase62
+	}
+	guid.encodeBaseN(dst, base62Alphabet)
+	return nil
+}
+
+// private - panics on undersized buffer or nil guid.
+// Encodes the 128-bit big-endian value of the Guid as 22 zero-padded digits of base len(alphabet).
+func (guid *Guid) encodeBaseN(dst []byte, alphabet string) {
+	// Bounds Check Elimination
+	_ = dst[GuidBase58ByteSize-1]
+
+	base := uint64(len(alphabet))
+	chunkBase := base
+	for range baseNChunkDigits - 1 {
+		chunkBase *= base
+	}
+
+	// Split the value into chunks with bits.Div64: hi:lo = (top*chunkBase + mid)*chunkBase + low.
+	// top has 2 digits (it is <= 1833 for Base58 and <= 483 for Base62), mid and low have 10 digits each.
+	hi, lo := guid.halves()
+	i := GuidBase58ByteSize
+	for chunk := range 3 {
+		var r uint64
+		digits := baseNChunkDigits
+		if chunk < 2 {
+			r = hi % chunkBase
+			hi /= chunkBase
+			lo, r = bits.Div64(r, lo, chunkBase)
+		} else {
+			r, digits = lo, GuidBase58ByteSize-2*baseNChunkDigits
+		}
+		for range digits {
+			i--
+			dst[i] = alphabet[r%base]
+			r /= base
+		}
+	}
+}
+
+//==============================================
+// Standalone Functions
+//==============================================
+
+// ParseBase58 parses a 22-char Base58 (Bitcoin alphabet) string into a Guid.
+func ParseBase58(s string) (Guid, error) {
+	// Zero-copy conversion of a string to a byte slice
+	return ParseBase58Bytes(unsafe.Slice(unsafe.StringData(s), len(s)))
+}
+
+// ParseBase58Bytes parses a 22-char Base58 (Bitcoin alphabet) string represented as a byte slice into a Guid.
+// ParseBase58Bytes is like ParseBase58, except it parses a string byte slice instead of a string.
+func ParseBase58Bytes(src []byte) (g Guid, err error) {
+	if len(src) != GuidBase58ByteSize || !DecodeBase58(g[:], src) {
+		return Guid{}, ErrInvalidBase58GuidEncoding
+	}
+	return g, nil
+}
+
+// DecodeBase58 decodes a 22-char Base58 (Bitcoin alphabet) src byte slice into a Guid dst byte slice.
+// Does not panic on invalid input. Returns false for values larger than 128 bits.
+// dst must be at least 16 bytes long and src must be at least 22 bytes long (returns false otherwise).
+// dst is not modified if the function returns false.
+func DecodeBase58(dst []byte, src []byte) (ok bool) {
+	return decodeBaseN(dst, src, &decodeLookupBase58, 58)
+}
+
+// ParseBase62 parses a 22-char Base62 (0-9A-Za-z) string into a Guid.
+func ParseBase62(s string) (Guid, error) {
+	// Zero-copy conversion of a string to a byte slice
+	return ParseBase62Bytes(unsafe.Slice(unsafe.StringData(s), len(s)))
+}
+
+// ParseBase62Bytes parses a 22-char Base62 (0-9A-Za-z) string represented as a byte slice into a Guid.
+// ParseBase62Bytes is like ParseBase62, except it parses a string byte slice instead of a string.
+func ParseBase62Bytes(src []byte) (g Guid, err error) {
+	if len(src) != GuidBase62ByteSize || !DecodeBase62(g[:], src) {
+		return Guid{}, ErrInvalidBase62GuidEncoding
+	}
+	return g, nil
+}
+
+// DecodeBase62 decodes a 22-char Base62 (0-9A-Za-z) src byte slice into a Guid dst byte slice.
+// Does not panic on invalid input. Returns false for values larger than 128 bits.
+// dst must be at least 16 bytes long and src must be at least 22 bytes long (returns false otherwise).
+// dst is not modified if the function returns false.
+func DecodeBase62(dst []byte, src []byte) (ok bool) {
+	return decodeBaseN(dst, src, &decodeLookupBase62, 62)
+}
+
+func decodeBaseN(dst []byte, src []byte, lookup *[256]byte, base uint64) (ok bool) {
+	if (len(dst) < GuidByteSize) || (len(src) < GuidBase58ByteSize) {
+		return false
+	}
+
+	var hi, lo uint64
+	for _, c := range src[:GuidBase58ByteSize] {
+		digit := lookup[c]
+		if digit == 0xFF {
+			return false
+		}
+		// hi:lo = hi:lo*base + digit, failing on 128-bit overflow
+		loHi, loLo := bits.Mul64(lo, base)
+		hiHi, hiLo := bits.Mul64(hi, base)
+		var carry uint64
+		lo, carry = bits.Add64(loLo, uint64(digit), 0)
+		hi, carry = bits.Add64(hiLo, loHi, carry)
+		if (hiHi | carry) != 0 {
+			return false
+		}
+	}
+
+	g := fromHalves(hi, lo)
+	copy(dst, g[:])
+	return true
+}
+
+// decodeLookupBase58 is a lookup table for decoding Base58 (Bitcoin alphabet) characters to their values.
+// Generated the same way as decodeLookup. Values outside the Base58 alphabet are marked with 0xFF.
+var decodeLookupBase58 = [256]byte{
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06,
+	0x07, 0x08, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F,
+	0x10, 0xFF, 0x11, 0x12, 0x13, 0x14, 0x15, 0xFF,
+	0x16, 0x17, 0x18, 0x19, 0x1A, 0x1B, 0x1C, 0x1D,
+	0x1E, 0x1F, 0x20, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27,
+	0x28, 0x29, 0x2A, 0x2B, 0xFF, 0x2C, 0x2D, 0x2E,
+	0x2F, 0x30, 0x31, 0x32, 0x33, 0x34, 0x35, 0x36,
+	0x37, 0x38, 0x39, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+}
+
+// decodeLookupBase62 is a lookup table for decoding Base62 (0-9A-Za-z) characters to their values.
+// Generated the same way as decodeLookup. Values outside the Base62 alphabet are marked with 0xFF.
+var decodeLookupBase62 = [256]byte{
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07,
+	0x08, 0x09, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10,
+	0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+	0x19, 0x1A, 0x1B, 0x1C, 0x1D, 0x1E, 0x1F, 0x20,
+	0x21, 0x22, 0x23, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2A,
+	0x2B, 0x2C, 0x2D, 0x2E, 0x2F, 0x30, 0x31, 0x32,
+	0x33, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3A,
+	0x3B, 0x3C, 0x3D, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+}
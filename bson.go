@@ -0,0 +1,119 @@
+package guid
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+const (
+	GuidBSONByteSize  = 4 + 1 + GuidByteSize // BSON binary value of a Guid is 21 bytes: [int32 length 16][subtype][16 bytes]
+	bsonTypeBinary    = 0x05                 // BSON binary element type
+	bsonTypeNull      = 0x0A                 // BSON null element type
+	bsonSubtypeUUID   = 0x04                 // BSON binary subtype 4: UUID (RFC 9562 byte order)
+	bsonSubtypeLegacy = 0x03                 // BSON binary subtype 3: legacy UUID (driver-specific byte order)
+)
+
+// ErrInvalidBSONGuidEncoding is returned when a BSON value is not a 16-byte binary Guid of the expected subtype.
+var ErrInvalidBSONGuidEncoding = errors.New("invalid BSON Guid encoding (not a 16-byte binary of the expected subtype)")
+
+// GuidCSharpLegacy is a Guid stored in MongoDB as BSON binary subtype 3 with the legacy C# driver byte order
+// (.NET System.Guid.ToByteArray() mixed-endian, see Guid.ToMicrosoftBytes).
+// The embedded Guid is in RFC 9562 byte order: its CanonicalString() matches the C# Guid.ToString().
+type GuidCSharpLegacy struct {
+	Guid // embedded
+}
+
+// GuidJavaLegacy is a Guid stored in MongoDB as BSON binary subtype 3 with the legacy Java driver byte order
+// (each 8-byte half of the Guid reversed).
+// The embedded Guid is in RFC 9562 byte order: its CanonicalString() matches the Java UUID.toString().
+type GuidJavaLegacy struct {
+	Guid // embedded
+}
+
+//==============================================
+// Guid Extension Methods
+//==============================================
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface (go.mongodb.org/mongo-driver/v2).
+// The Guid is encoded as BSON binary subtype 4 (UUID), so that MongoDB indexes it as 16 bytes
+// and other drivers read it as a UUID. GuidPG, GuidSS, GuidV7 and GuidMySQL are encoded the same way (their bytes as is).
+func (guid Guid) MarshalBSONValue() (typ byte, data []byte, err error) {
+	return bsonTypeBinary, guid.bsonBinary(bsonSubtypeUUID), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface (go.mongodb.org/mongo-driver/v2).
+// It accepts a 16-byte BSON binary subtype 4 (UUID), or BSON null (unmarshaled as Nil).
+// Legacy subtype 3 values are rejected, because their byte order is unknown: use GuidCSharpLegacy or GuidJavaLegacy.
+func (guid *Guid) UnmarshalBSONValue(typ byte, data []byte) error {
+	return guid.unmarshalBSONBinary(typ, data, bsonSubtypeUUID)
+}
+
+// bsonBinary returns the BSON binary value [int32 length 16][subtype][16 bytes] of the Guid.
+func (guid *Guid) bsonBinary(subtype byte) []byte {
+	data := make([]byte, GuidBSONByteSize)
+	binary.LittleEndian.PutUint32(data, GuidByteSize)
+	data[4] = subtype
+	copy(data[5:], guid[:])
+	return data
+}
+
+// unmarshalBSONBinary copies the 16 bytes of a BSON binary value with the given subtype (or null, as Nil) into the Guid.
+func (guid *Guid) unmarshalBSONBinary(typ byte, data []byte, subtype byte) error {
+	switch {
+	case typ == bsonTypeNull && len(data) == 0:
+		*guid = Guid{}
+		return nil
+	case typ != bsonTypeBinary || len(data) != GuidBSONByteSize ||
+		binary.LittleEndian.Uint32(data) != GuidByteSize || data[4] != subtype:
+		return fmt.Errorf("%w: BSON type 0x%02x, subtype 0x%02x expected", ErrInvalidBSONGuidEncoding, typ, subtype)
+	}
+	copy(guid[:], data[5:])
+	return nil
+}
+
+//==============================================
+// GuidCSharpLegacy and GuidJavaLegacy Extension Methods
+//==============================================
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface (go.mongodb.org/mongo-driver/v2).
+// The Guid is encoded as BSON binary subtype 3 in the legacy C# driver byte order.
+func (g GuidCSharpLegacy) MarshalBSONValue() (typ byte, data []byte, err error) {
+	swapMicrosoftBytes(&g.Guid)
+	return bsonTypeBinary, g.Guid.bsonBinary(bsonSubtypeLegacy), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface (go.mongodb.org/mongo-driver/v2).
+// It accepts a 16-byte BSON binary subtype 3 in the legacy C# driver byte order, or BSON null (unmarshaled as Nil).
+func (g *GuidCSharpLegacy) UnmarshalBSONValue(typ byte, data []byte) error {
+	if err := g.Guid.unmarshalBSONBinary(typ, data, bsonSubtypeLegacy); err != nil {
+		return err
+	}
+	swapMicrosoftBytes(&g.Guid)
+	return nil
+}
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface (go.mongodb.org/mongo-driver/v2).
+// The Guid is encoded as BSON binary subtype 3 in the legacy Java driver byte order.
+func (g GuidJavaLegacy) MarshalBSONValue() (typ byte, data []byte, err error) {
+	swapJavaLegacyBytes(&g.Guid)
+	return bsonTypeBinary, g.Guid.bsonBinary(bsonSubtypeLegacy), nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface (go.mongodb.org/mongo-driver/v2).
+// It accepts a 16-byte BSON binary subtype 3 in the legacy Java driver byte order, or BSON null (unmarshaled as Nil).
+func (g *GuidJavaLegacy) UnmarshalBSONValue(typ byte, data []byte) error {
+	if err := g.Guid.unmarshalBSONBinary(typ, data, bsonSubtypeLegacy); err != nil {
+		return err
+	}
+	swapJavaLegacyBytes(&g.Guid)
+	return nil
+}
+
+// swapJavaLegacyBytes converts between RFC 9562 and legacy Java driver byte order (each 8-byte half reversed), in place.
+// The conversion is its own inverse.
+func swapJavaLegacyBytes(g *Guid) {
+	hi, lo := g.halves()
+	*g = fromHalves(bits.ReverseBytes64(hi), bits.ReverseBytes64(lo))
+}
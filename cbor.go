@@ -0,0 +1,40 @@
+package guid
+
+import "errors"
+
+const (
+	GuidCBORByteSize   = 3 + GuidByteSize    // CBOR encoding of a Guid is 19 bytes: [0xd8 0x25 tag 37][0x50 bstr header][16 bytes]
+	cborTag1ByteHeader = 0xd8                // CBOR tag with a 1-byte tag number
+	cborTag37          = 0x25                // CBOR tag 37: RFC 9562 UUID
+	cborBstr16Header   = 0x40 | GuidByteSize // CBOR bstr header with a 16-byte length
+)
+
+// ErrInvalidCBORGuidEncoding is returned when CBOR data is not a (tag 37) 16-byte bstr Guid.
+var ErrInvalidCBORGuidEncoding = errors.New("invalid CBOR Guid encoding (not a 16-byte bstr, optionally with tag 37)")
+
+//==============================================
+// Guid Extension Methods
+//==============================================
+
+// MarshalCBOR implements the cbor.Marshaler interface (e.g. github.com/fxamacker/cbor/v2).
+// The Guid is encoded as a 16-byte CBOR bstr with tag 37 (RFC 9562 UUID): [0xd8 0x25][0x50][16 bytes].
+// GuidPG and GuidSS are encoded the same way (their bytes as is).
+func (guid Guid) MarshalCBOR() ([]byte, error) {
+	data := make([]byte, GuidCBORByteSize)
+	data[0], data[1], data[2] = cborTag1ByteHeader, cborTag37, cborBstr16Header
+	copy(data[3:], guid[:])
+	return data, nil
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface (e.g. github.com/fxamacker/cbor/v2).
+// It accepts a 16-byte CBOR bstr, with or without tag 37, and rejects any other tag or length.
+func (guid *Guid) UnmarshalCBOR(data []byte) error {
+	if len(data) == GuidCBORByteSize && data[0] == cborTag1ByteHeader && data[1] == cborTag37 {
+		data = data[2:] // strip tag 37
+	}
+	if len(data) != 1+GuidByteSize || data[0] != cborBstr16Header {
+		return ErrInvalidCBORGuidEncoding
+	}
+	copy(guid[:], data[1:])
+	return nil
+}
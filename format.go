@@ -0,0 +1,165 @@
+package guid
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"unsafe"
+)
+
+// Format identifies a textual Guid representation recognized by ParseAny.
+type Format uint8
+
+const (
+	FormatUnknown         Format = iota // Not a recognized Guid text form
+	FormatBase64URL                     // 22-char Base64Url (Guid.String)
+	FormatBase64URLPadded               // 24-char Base64Url with "==" padding
+	FormatBase64Std                     // 22-char standard Base64 ("+/" alphabet)
+	FormatBase64StdPadded               // 24-char standard Base64 with "==" padding
+	FormatHex                           // 32-char hex without hyphens
+	FormatCanonical                     // 36-char canonical hyphenated 8-4-4-4-12 hex
+	FormatBraced                        // 38-char {braced} canonical hex
+	FormatURN                           // 45-char urn:uuid: prefixed canonical hex
+)
+
+var formatNames = [...]string{
+	FormatUnknown:         "Unknown",
+	FormatBase64URL:       "Base64Url",
+	FormatBase64URLPadded: "Base64UrlPadded",
+	FormatBase64Std:       "Base64Std",
+	FormatBase64StdPadded: "Base64StdPadded",
+	FormatHex:             "Hex",
+	FormatCanonical:       "Canonical",
+	FormatBraced:          "Braced",
+	FormatURN:             "URN",
+}
+
+// ErrUnrecognizedGuidFormat is returned by ParseAny when a string does not match any supported Guid text form.
+var ErrUnrecognizedGuidFormat = errors.New("unrecognized Guid format")
+
+// lenientUnmarshal makes UnmarshalText and UnmarshalJSON accept every format recognized by ParseAny.
+var lenientUnmarshal atomic.Bool
+
+// LenientGuid is a Guid whose UnmarshalText and UnmarshalJSON always accept every text form recognized by ParseAny,
+// regardless of SetLenientUnmarshal. Use it for the fields that must accept foreign Guid forms,
+// without changing how other Guids in the process unmarshal. It marshals like Guid, to the 22-char Base64Url form.
+type LenientGuid struct {
+	Guid // embedded
+}
+
+// String returns the name of the Format.
+func (f Format) String() string {
+	if int(f) < len(formatNames) {
+		return formatNames[f]
+	}
+	return fmt.Sprintf("Format(%d)", uint8(f))
+}
+
+// SetLenientUnmarshal controls whether Guid.UnmarshalText and Guid.UnmarshalJSON accept every text form
+// recognized by ParseAny (enabled), or only the 22-char Base64Url form (disabled, the default).
+// The setting is process-wide: it applies to every Guid (and GuidPG, GuidSS, NullGuid, etc.) unmarshaled
+// by any package in the process, including dependencies. Use LenientGuid to accept every form in one place only.
+// It is safe for concurrent use.
+func SetLenientUnmarshal(enabled bool) {
+	lenientUnmarshal.Store(enabled)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts every text form recognized by ParseAny.
+func (g *LenientGuid) UnmarshalText(data []byte) error {
+	return g.Guid.unmarshalText(data, true)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts null (unmarshaled as Nil), or a JSON string in every text form recognized by ParseAny.
+func (g *LenientGuid) UnmarshalJSON(data []byte) error {
+	return g.Guid.unmarshalJSON(data, true)
+}
+
+// ParseAny parses s in any supported Guid text form, and reports which Format matched.
+// Supported forms: Base64Url and standard Base64 (both with optional "==" padding), 32-char hex,
+// canonical 8-4-4-4-12 hex, {braced} canonical hex, and urn:uuid: prefixed canonical hex.
+// Hex forms accept upper and lower case. Strings valid in both Base64 alphabets are reported as FormatBase64URL.
+func ParseAny(s string) (Guid, Format, error) {
+	// Zero-copy conversion of a string to a byte slice
+	return parseAny(unsafe.Slice(unsafe.StringData(s), len(s)))
+}
+
+// ParseAnyBytes is like ParseAny, except it parses a string byte slice instead of a string.
+func ParseAnyBytes(src []byte) (Guid, Format, error) {
+	return parseAny(src)
+}
+
+func parseAny(src []byte) (g Guid, f Format, err error) {
+	const urnPrefix = "urn:uuid:"
+	ok := false
+
+	switch len(src) {
+	case GuidBase64UrlByteSize + 2:
+		if src[GuidBase64UrlByteSize] != '=' || src[GuidBase64UrlByteSize+1] != '=' {
+			break
+		}
+		if ok = DecodeBase64URL(g[:], src); ok {
+			f = FormatBase64URLPadded
+		} else if ok = decodeBase64(g[:], src, &decodeLookupStd); ok {
+			f = FormatBase64StdPadded
+		}
+	case GuidBase64UrlByteSize:
+		if ok = DecodeBase64URL(g[:], src); ok {
+			f = FormatBase64URL
+		} else if ok = decodeBase64(g[:], src, &decodeLookupStd); ok {
+			f = FormatBase64Std
+		}
+	case GuidHexByteSize:
+		ok, f = decodeHex(g[:], src), FormatHex
+	case GuidCanonicalByteSize:
+		ok, f = DecodeCanonical(g[:], src), FormatCanonical
+	case GuidCanonicalByteSize + 2:
+		ok = src[0] == '{' && src[GuidCanonicalByteSize+1] == '}' && DecodeCanonical(g[:], src[1:])
+		f = FormatBraced
+	case GuidCanonicalByteSize + len(urnPrefix):
+		ok = hasPrefixFold(src, urnPrefix) && DecodeCanonical(g[:], src[len(urnPrefix):])
+		f = FormatURN
+	}
+
+	if !ok {
+		return Guid{}, FormatUnknown, ErrUnrecognizedGuidFormat
+	}
+	return g, f, nil
+}
+
+// decodeHex decodes 32 hex characters (upper or lower case) into a Guid dst byte slice.
+// dst is modified even if the function returns false.
+func decodeHex(dst []byte, src []byte) (ok bool) {
+	if (len(dst) < GuidByteSize) || (len(src) < GuidHexByteSize) {
+		return false
+	}
+
+	// Bounds Check Elimination:
+	_ = dst[GuidByteSize-1]
+	_ = src[GuidHexByteSize-1]
+
+	for i := range GuidByteSize {
+		hi := hexDecodeLookup[src[2*i]]
+		lo := hexDecodeLookup[src[2*i+1]]
+
+		if (hi | lo) >= 16 {
+			return false
+		}
+		dst[i] = (hi << 4) | lo
+	}
+	return true
+}
+
+// hasPrefixFold reports whether src begins with the lowercase ASCII prefix, ignoring ASCII case.
+func hasPrefixFold(src []byte, prefix string) bool {
+	if len(src) < len(prefix) {
+		return false
+	}
+	for i := range len(prefix) {
+		c, p := src[i], prefix[i]
+		if c != p && !('a' <= p && p <= 'z' && c|0x20 == p) {
+			return false
+		}
+	}
+	return true
+}
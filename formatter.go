@@ -0,0 +1,118 @@
+package guid
+
+import "fmt"
+
+const (
+	// goLiteralByteSize is the length of the %#v Go literal: guid.Guid{0x00, ..., 0x00}
+	goLiteralByteSize = len("guid.Guid{}") + GuidByteSize*len("0x00") + (GuidByteSize-1)*len(", ")
+	// goLiteralMaxByteSize is the length of the longest %#v Go literal: guid.GuidCSharpLegacy{Guid:guid.Guid{...}}
+	goLiteralMaxByteSize = len("guid.GuidCSharpLegacy{Guid:}") + goLiteralByteSize
+)
+
+//==============================================
+// Compile-time interface assertions
+//==============================================
+
+var (
+	_ fmt.Formatter = Guid{}
+	_ fmt.Formatter = GuidPG{}
+	_ fmt.Formatter = GuidSS{}
+	_ fmt.Formatter = GuidV7{}
+	_ fmt.Formatter = GuidMySQL{}
+	_ fmt.Formatter = GuidCSharpLegacy{}
+	_ fmt.Formatter = GuidJavaLegacy{}
+	_ fmt.Formatter = LenientGuid{}
+)
+
+//==============================================
+// Guid Extension Methods
+//==============================================
+
+// Format implements fmt.Formatter, for both Guid values and pointers:
+//
+//	%s, %v   22-char Base64Url (like String)
+//	%+s, %+v canonical hyphenated 8-4-4-4-12 lowercase hex (like CanonicalString)
+//	%q, %+q  double-quoted Base64Url or canonical form (%#q uses backquotes)
+//	%#v      Go literal: guid.Guid{0x01, 0x23, ...}
+//
+// %#v always prints the value literal: for a *Guid the result lacks the leading '&',
+// because Format cannot tell a pointer from a value.
+//
+// Width, precision and the '-' and '0' flags apply to the text as they do to a string.
+// Other verbs format the Guid as a [16]byte: %x and %X print 32-char lowercase or uppercase hex (%#x adds a 0x prefix).
+func (guid Guid) Format(f fmt.State, verb rune) {
+	guid.format(f, verb, "")
+}
+
+// format implements fmt.Formatter for the Guid and the types that embed it.
+// typeName is the name of the embedding type for the %#v Go literal, or "" for Guid itself.
+func (guid *Guid) format(f fmt.State, verb rune, typeName string) {
+	var buffer [goLiteralMaxByteSize]byte
+	b := buffer[:0]
+
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		b = guid.appendGoLiteral(b, typeName)
+		verb = 's'
+	case verb == 'v' || verb == 's' || verb == 'q':
+		if f.Flag('+') {
+			b = guid.AppendCanonical(b)
+		} else {
+			b, _ = guid.AppendText(b)
+		}
+		if verb == 'v' {
+			verb = 's'
+		}
+	default:
+		fmt.Fprintf(f, fmt.FormatString(f, verb), [GuidByteSize]byte(*guid))
+		return
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), b)
+}
+
+// appendGoLiteral appends the Go composite literal of the Guid to b: guid.Guid{0x01, 0x23, ...},
+// wrapped in the literal of the embedding type if typeName is not empty: guid.GuidPG{Guid:guid.Guid{...}}
+func (guid *Guid) appendGoLiteral(b []byte, typeName string) []byte {
+	if typeName != "" {
+		b = append(b, "guid."...)
+		b = append(b, typeName...)
+		b = append(b, "{Guid:"...)
+	}
+	b = append(b, "guid.Guid{"...)
+	for i, v := range guid {
+		if i > 0 {
+			b = append(b, ", "...)
+		}
+		b = append(b, '0', 'x', hexAlphabet[v>>4], hexAlphabet[v&0x0F])
+	}
+	b = append(b, '}')
+	if typeName != "" {
+		b = append(b, '}')
+	}
+	return b
+}
+
+//==============================================
+// Embedding Types Extension Methods
+//==============================================
+
+// Format implements fmt.Formatter like Guid.Format. %#v prints a guid.GuidPG Go literal.
+func (g GuidPG) Format(f fmt.State, verb rune) { g.Guid.format(f, verb, "GuidPG") }
+
+// Format implements fmt.Formatter like Guid.Format. %#v prints a guid.GuidSS Go literal.
+func (g GuidSS) Format(f fmt.State, verb rune) { g.Guid.format(f, verb, "GuidSS") }
+
+// Format implements fmt.Formatter like Guid.Format. %#v prints a guid.GuidV7 Go literal.
+func (g GuidV7) Format(f fmt.State, verb rune) { g.Guid.format(f, verb, "GuidV7") }
+
+// Format implements fmt.Formatter like Guid.Format. %#v prints a guid.GuidMySQL Go literal.
+func (g GuidMySQL) Format(f fmt.State, verb rune) { g.Guid.format(f, verb, "GuidMySQL") }
+
+// Format implements fmt.Formatter like Guid.Format. %#v prints a guid.GuidCSharpLegacy Go literal.
+func (g GuidCSharpLegacy) Format(f fmt.State, verb rune) { g.Guid.format(f, verb, "GuidCSharpLegacy") }
+
+// Format implements fmt.Formatter like Guid.Format. %#v prints a guid.GuidJavaLegacy Go literal.
+func (g GuidJavaLegacy) Format(f fmt.State, verb rune) { g.Guid.format(f, verb, "GuidJavaLegacy") }
+
+// Format implements fmt.Formatter like Guid.Format. %#v prints a guid.LenientGuid Go literal.
+func (g LenientGuid) Format(f fmt.State, verb rune) { g.Guid.format(f, verb, "LenientGuid") }
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"math/bits"
+	"slices"
 	"sync"
 	"time"
 	"unsafe"
@@ -26,10 +27,13 @@ const (
 	guidsPerCache         = 256                          // 256 Guids per cache - do not change this value
 	guidCacheByteSize     = GuidByteSize * guidsPerCache // 4096 bytes per cache (256*16)
 	GuidBase64UrlByteSize = 22                           // Base64Url encoding of a Guid is 22 characters
+	GuidCanonicalByteSize = 36                           // Canonical hyphenated (8-4-4-4-12) encoding of a Guid is 36 characters
+	GuidHexByteSize       = 32                           // Hex encoding (without hyphens) of a Guid is 32 characters
 )
 
 const (
 	base64UrlAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_" // Base64Url alphabet used for encoding
+	hexAlphabet       = "0123456789abcdef"                                                 // lowercase hex alphabet used for canonical encoding
 )
 
 // Ensure that the constants are not changed without thought.
@@ -61,6 +65,10 @@ var (
 	ErrInvalidGuidSlice = errors.New("invalid Guid slice (length < 16 bytes)")
 	// ErrBufferTooSmallBase64Url is returned when a destination slice is too small to receive the text-encoded Guid.
 	ErrBufferTooSmallBase64Url = fmt.Errorf("buffer is too small (length < %d bytes)", GuidBase64UrlByteSize)
+	// ErrInvalidCanonicalGuidEncoding is returned when a string does not represent a valid canonical hyphenated Guid.
+	ErrInvalidCanonicalGuidEncoding = errors.New("invalid canonical Guid encoding (invalid characters, or length != 36)")
+	// ErrBufferTooSmallCanonical is returned when a destination slice is too small to receive the canonical Guid.
+	ErrBufferTooSmallCanonical = fmt.Errorf("buffer is too small (length < %d bytes)", GuidCanonicalByteSize)
 )
 
 //==============================================
@@ -73,6 +81,8 @@ var (
 	_ encoding.TextUnmarshaler   = &Guid{}
 	_ encoding.BinaryMarshaler   = Guid{}
 	_ encoding.BinaryUnmarshaler = &Guid{}
+	_ encoding.TextAppender      = Guid{}
+	_ encoding.BinaryAppender    = Guid{}
 	_ io.Reader                  = reader{}
 )
 
@@ -128,7 +138,21 @@ func (guid *Guid) UnmarshalBinary(data []byte) error {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts the Base64Url form, or any ParseAny form if enabled via SetLenientUnmarshal (see also LenientGuid).
 func (guid *Guid) UnmarshalText(data []byte) error {
+	return guid.unmarshalText(data, lenientUnmarshal.Load())
+}
+
+// unmarshalText accepts the Base64Url form, or any ParseAny form if lenient.
+func (guid *Guid) unmarshalText(data []byte, lenient bool) error {
+	if lenient {
+		g, _, err := parseAny(data)
+		if err != nil {
+			return err
+		}
+		*guid = g
+		return nil
+	}
 	if ok := DecodeBase64URL(guid[:], data); !ok {
 		return ErrInvalidBase64UrlGuidEncoding
 	}
@@ -137,29 +161,68 @@ func (guid *Guid) UnmarshalText(data []byte) error {
 
 // MarshalText implements encoding.TextMarshaler.
 func (guid *Guid) MarshalText() ([]byte, error) {
-	buffer := make([]byte, GuidBase64UrlByteSize)
-	guid.encodeBase64URL(buffer)
-	return buffer, nil
+	return guid.AppendText(make([]byte, 0, GuidBase64UrlByteSize))
+}
+
+// AppendText implements encoding.TextAppender.
+// It appends the 22-char Base64Url form of the Guid to b, and does not allocate if b has enough capacity.
+func (guid Guid) AppendText(b []byte) ([]byte, error) {
+	b, dst := grow(b, GuidBase64UrlByteSize)
+	guid.encodeBase64URL(dst)
+	return b, nil
+}
+
+// AppendBinary implements encoding.BinaryAppender.
+// It appends the 16 raw bytes of the Guid to b.
+func (guid Guid) AppendBinary(b []byte) ([]byte, error) {
+	return append(b, guid[:]...), nil
+}
+
+// AppendCanonical appends the canonical hyphenated (8-4-4-4-12) lowercase hex form of the Guid to b.
+func (guid Guid) AppendCanonical(b []byte) []byte {
+	b, dst := grow(b, GuidCanonicalByteSize)
+	guid.encodeCanonical(dst)
+	return b
+}
+
+// AppendHex appends the 32-char lowercase hex form of the Guid (without hyphens) to b.
+func (guid Guid) AppendHex(b []byte) []byte {
+	b, dst := grow(b, GuidHexByteSize)
+	guid.encodeHex(dst)
+	return b
 }
 
 // MarshalJSON implements the json.Marshaler interface.
 // It marshals the Guid to its Base64Url string representation.
 func (g Guid) MarshalJSON() ([]byte, error) {
 	//return json.Marshal(g.String())
-	gStringWithQuotes := make([]byte, GuidBase64UrlByteSize+2)
-	gStringWithQuotes[1+GuidBase64UrlByteSize], gStringWithQuotes[0] = '"', '"'
-	g.encodeBase64URL(gStringWithQuotes[1 : 1+GuidBase64UrlByteSize])
-	return gStringWithQuotes, nil
+	gStringWithQuotes := make([]byte, 1, GuidBase64UrlByteSize+2)
+	gStringWithQuotes[0] = '"'
+	gStringWithQuotes, _ = g.AppendText(gStringWithQuotes)
+	return append(gStringWithQuotes, '"'), nil
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
 // It unmarshals a JSON string into a Guid.
+// It accepts the Base64Url form, or any ParseAny form if enabled via SetLenientUnmarshal (see also LenientGuid).
 func (g *Guid) UnmarshalJSON(data []byte) error {
+	return g.unmarshalJSON(data, lenientUnmarshal.Load())
+}
+
+// unmarshalJSON accepts null, a Base64Url string, or any ParseAny form string if lenient.
+func (g *Guid) unmarshalJSON(data []byte, lenient bool) error {
 	if string(data) == "null" {
 		*g = Guid{}
 		return nil // valid null Guid
 	}
 
+	if n := len(data); lenient && n >= 2 && data[0] == '"' && data[n-1] == '"' {
+		if parsed, _, err := parseAny(data[1 : n-1]); err == nil {
+			*g = parsed
+			return nil
+		}
+	}
+
 	if len(data) != (GuidBase64UrlByteSize+2) || !DecodeBase64URL(g[:], data[1:1+GuidBase64UrlByteSize]) {
 		return fmt.Errorf("guid: cannot unmarshal JSON string %q into a Guid", string(data))
 	}
@@ -199,12 +262,18 @@ func (guid *Guid) EncodeBase64URL(dst []byte) error {
 
 // private - panics on undersized buffer or nil guid
 func (guid *Guid) encodeBase64URL(dst []byte) {
+	guid.encodeBase64(dst, base64UrlAlphabet)
+}
+
+// private - panics on undersized buffer, nil guid, or an alphabet shorter than 64 chars
+func (guid *Guid) encodeBase64(dst []byte, alphabet string) {
 	const lengthMod3 = 1                    // 16 % 3 = 1
 	const limit = GuidByteSize - lengthMod3 // 15 bytes can be processed in groups of 3 bytes, leaving 1 byte at the end.
 
 	// Bounds Check Elimination
 	_ = guid[GuidByteSize-1]
 	_ = dst[GuidBase64UrlByteSize-1]
+	_ = alphabet[63]
 
 	j := 0 // Index in the output buffer
 
@@ -213,17 +282,66 @@ func (guid *Guid) encodeBase64URL(dst []byte) {
 		val := uint(guid[i])<<16 | uint(guid[i+1])<<8 | uint(guid[i+2])
 
 		// Combine 3 bytes into a 24-bit integer and extract 4 6-bit indices.
-		dst[j] = base64UrlAlphabet[val>>18&0x3F]
-		dst[j+1] = base64UrlAlphabet[val>>12&0x3F]
-		dst[j+2] = base64UrlAlphabet[val>>6&0x3F]
-		dst[j+3] = base64UrlAlphabet[val&0x3F]
+		dst[j] = alphabet[val>>18&0x3F]
+		dst[j+1] = alphabet[val>>12&0x3F]
+		dst[j+2] = alphabet[val>>6&0x3F]
+		dst[j+3] = alphabet[val&0x3F]
 		j += 4
 	}
 
 	// Handle the last byte, converted to 2 Base64Url characters.
 	b0 := guid[limit]
-	dst[j] = base64UrlAlphabet[b0>>2]
-	dst[j+1] = base64UrlAlphabet[(b0&0x03)<<4]
+	dst[j] = alphabet[b0>>2]
+	dst[j+1] = alphabet[(b0&0x03)<<4]
+}
+
+// CanonicalString returns the canonical RFC 9562 hyphenated (8-4-4-4-12) lowercase hex representation of the Guid.
+func (guid *Guid) CanonicalString() string {
+	buffer := make([]byte, GuidCanonicalByteSize)
+	guid.encodeCanonical(buffer)
+	return unsafe.String(&buffer[0], GuidCanonicalByteSize) // same approach as String()
+}
+
+// EncodeCanonical encodes the Guid into the provided dst as canonical hyphenated (8-4-4-4-12) lowercase hex.
+func (guid *Guid) EncodeCanonical(dst []byte) error {
+	if len(dst) < GuidCanonicalByteSize {
+		return ErrBufferTooSmallCanonical
+	}
+	guid.encodeCanonical(dst)
+	return nil
+}
+
+// private - panics on undersized buffer or nil guid
+func (guid *Guid) encodeCanonical(dst []byte) {
+	// Bounds Check Elimination
+	_ = guid[GuidByteSize-1]
+	_ = dst[GuidCanonicalByteSize-1]
+
+	for i, offset := range canonicalHexOffsets {
+		b := guid[i]
+		dst[offset] = hexAlphabet[b>>4]
+		dst[offset+1] = hexAlphabet[b&0x0F]
+	}
+	dst[8], dst[13], dst[18], dst[23] = '-', '-', '-', '-'
+}
+
+// private - panics on undersized buffer or nil guid
+func (guid *Guid) encodeHex(dst []byte) {
+	// Bounds Check Elimination
+	_ = guid[GuidByteSize-1]
+	_ = dst[GuidHexByteSize-1]
+
+	for i, b := range guid {
+		dst[2*i] = hexAlphabet[b>>4]
+		dst[2*i+1] = hexAlphabet[b&0x0F]
+	}
+}
+
+// grow extends b by n bytes, and returns the extended slice and its n-byte tail to encode into.
+func grow(b []byte, n int) (extended []byte, tail []byte) {
+	b = slices.Grow(b, n)
+	extended = b[:len(b)+n]
+	return extended, extended[len(b):]
 }
 
 //==============================================
@@ -273,12 +391,25 @@ func (r reader) Read(b []byte) (int, error) {
 // The timestamp is stored in the first 8 bytes as nanoseconds since Unix epoch.
 // Returns the time.Time representation of when the Guid was created.
 func (g *GuidPG) Timestamp() time.Time {
+	return time.Unix(0, g.unixNano()).UTC()
+}
+
+// ToSS converts the PostgreSQL Guid into a GuidSS with the same timestamp and the same 8 random bytes.
+// The conversion is lossless: g.ToSS().ToPG() == g, and time order is preserved.
+func (g *GuidPG) ToSS() (gss GuidSS) {
+	copy(gss.Guid[:8], g.Guid[8:])
+	gss.setTimestamp(g.unixNano())
+	return
+}
+
+// private - extracts the nanosecond timestamp from the first 8 bytes
+func (g *GuidPG) unixNano() int64 {
 	timestamp := *(*int64)(unsafe.Pointer(&g.Guid[0])) // Extract timestamp from first 8 bytes
 
 	if !cpu.IsBigEndian {
 		timestamp = int64(bits.ReverseBytes64(uint64(timestamp)))
 	}
-	return time.Unix(0, timestamp).UTC()
+	return timestamp
 }
 
 //==============================================
@@ -289,9 +420,21 @@ func (g *GuidPG) Timestamp() time.Time {
 // The timestamp is stored in the last 8 bytes using SQL Server's Guid ordering rules.
 // Returns the time.Time representation of when the Guid was created.
 func (g *GuidSS) Timestamp() time.Time {
+	return time.Unix(0, g.unixNano()).UTC()
+}
+
+// ToPG converts the SQL Server Guid into a GuidPG with the same timestamp and the same 8 random bytes.
+// The conversion is lossless: g.ToPG().ToSS() == g, and time order is preserved.
+func (g *GuidSS) ToPG() (gpg GuidPG) {
+	copy(gpg.Guid[8:], g.Guid[:8])
+	gpg.setTimestamp(g.unixNano())
+	return
+}
+
+// private - extracts the nanosecond timestamp from the last 8 bytes
+func (g *GuidSS) unixNano() int64 {
 	encoded := *(*uint64)(unsafe.Pointer(&g.Guid[8])) // Extract timestamp from last 8 bytes (SQL Server format)
-	timestamp := int64(bits.RotateLeft64(bits.ReverseBytes64(encoded), 16))
-	return time.Unix(0, timestamp).UTC()
+	return int64(bits.RotateLeft64(bits.ReverseBytes64(encoded), 16))
 }
 
 //==============================================
@@ -326,13 +469,26 @@ func NewPG() GuidPG {
 	return newPG(time.Now().UnixNano())
 }
 
+// NewPGAt generates a new PostgreSQL sortable Guid as [8-byte t timestamp][8 random bytes].
+// Use it to backfill historical rows with Guids that sort by their original creation time.
+// The timestamp is t.UnixNano(), so t must be between 1970-01-01 and 2262-04-11 UTC: a pre-1970 timestamp is negative
+// and sorts after every later one, and a time outside 1678-2262 does not fit and yields an undefined timestamp.
+func NewPGAt(t time.Time) GuidPG {
+	return newPG(t.UnixNano())
+}
+
 func newPG(ts int64) (gpg GuidPG) {
 	gpg.Guid = New()
+	gpg.setTimestamp(ts)
+	return
+}
+
+// private - stores ts big-endian in the first 8 bytes
+func (g *GuidPG) setTimestamp(ts int64) {
 	if !cpu.IsBigEndian {
 		ts = int64(bits.ReverseBytes64(uint64(ts)))
 	}
-	*(*uint64)(unsafe.Pointer(&gpg.Guid[0])) = uint64(ts)
-	return
+	*(*uint64)(unsafe.Pointer(&g.Guid[0])) = uint64(ts)
 }
 
 // NewSS generates a new SQL Server sortable Guid as [8 random bytes][8 bytes of SQL Server ordered time.Now() timestamp]
@@ -340,15 +496,27 @@ func NewSS() GuidSS {
 	return newSS(time.Now().UnixNano())
 }
 
+// NewSSAt generates a new SQL Server sortable Guid as [8 random bytes][8 bytes of SQL Server ordered t timestamp].
+// Use it to backfill historical rows with Guids that sort by their original creation time.
+// t must be between 1970-01-01 and 2262-04-11 UTC, as for NewPGAt.
+func NewSSAt(t time.Time) GuidSS {
+	return newSS(t.UnixNano())
+}
+
 func newSS(ts int64) (gss GuidSS) {
 	// based on Microsoft SqlGuid.cs
 	// https://github.com/microsoft/referencesource/blob/5697c29004a34d80acdaf5742d7e699022c64ecd/System.Data/System/Data/SQLTypes/SQLGuid.cs
 	gss.Guid = New()
-	// we don't worry about big-endian, because SQL Server does not run on big-endian
-	*(*uint64)(unsafe.Pointer(&gss.Guid[8])) = bits.ReverseBytes64(bits.RotateLeft64(uint64(ts), -16))
+	gss.setTimestamp(ts)
 	return
 }
 
+// private - stores ts in the last 8 bytes using SQL Server's Guid ordering rules
+func (g *GuidSS) setTimestamp(ts int64) {
+	// we don't worry about big-endian, because SQL Server does not run on big-endian
+	*(*uint64)(unsafe.Pointer(&g.Guid[8])) = bits.ReverseBytes64(bits.RotateLeft64(uint64(ts), -16))
+}
+
 // NewString generates a new cryptographically secure Guid, and returns it as a Base64Url string.
 // NewString is equivalent to "g := guid.New(); return g.String();".
 func NewString() string {
@@ -386,6 +554,36 @@ func ParseBytes(src []byte) (g Guid, err error) {
 	return g, nil
 }
 
+// ParseCanonical parses a canonical hyphenated (8-4-4-4-12) hex string into the Guid.
+// Both upper and lower case hex are accepted.
+// Returns an error if the string is not a valid canonical Guid encoding.
+func ParseCanonical(s string) (g Guid, err error) {
+	if len(s) != GuidCanonicalByteSize {
+		return Guid{}, ErrInvalidCanonicalGuidEncoding
+	}
+
+	// Zero-copy conversion of a string to a byte slice
+	sBytes := unsafe.Slice(unsafe.StringData(s), GuidCanonicalByteSize)
+
+	if ok := DecodeCanonical(g[:], sBytes); !ok {
+		return Guid{}, ErrInvalidCanonicalGuidEncoding
+	}
+	return g, nil
+}
+
+// ParseCanonicalBytes parses a canonical hyphenated (8-4-4-4-12) hex string represented as a byte slice into the Guid.
+// ParseCanonicalBytes is like ParseCanonical, except it parses a string byte slice instead of a string.
+func ParseCanonicalBytes(src []byte) (g Guid, err error) {
+	if len(src) != GuidCanonicalByteSize {
+		return Guid{}, ErrInvalidCanonicalGuidEncoding
+	}
+
+	if ok := DecodeCanonical(g[:], src); !ok {
+		return Guid{}, ErrInvalidCanonicalGuidEncoding
+	}
+	return g, nil
+}
+
 // FromBytes returns a Guid from a 16-byte slice.
 func FromBytes(src []byte) (Guid, error) {
 	if len(src) < GuidByteSize {
@@ -401,6 +599,12 @@ func FromBytes(src []byte) (Guid, error) {
 // dst must be at least 16 bytes long and src must be at least 22 bytes long (returns false otherwise).
 // dst is modified even if the function returns false.
 func DecodeBase64URL(dst []byte, src []byte) (ok bool) {
+	return decodeBase64(dst, src, &decodeLookup)
+}
+
+// private - decodes 22 Base64 characters of the alphabet described by lookup into 16 Guid bytes.
+// Same contract as DecodeBase64URL.
+func decodeBase64(dst []byte, src []byte, lookup *[256]byte) (ok bool) {
 	if (len(dst) < GuidByteSize) || (len(src) < GuidBase64UrlByteSize) {
 		return false
 	}
@@ -416,10 +620,10 @@ func DecodeBase64URL(dst []byte, src []byte) (ok bool) {
 
 	// Process 5 groups of 4 characters to 3 bytes
 	for i := 0; i < limit; i += 3 {
-		b0 := decodeLookup[src[j]]
-		b1 := decodeLookup[src[j+1]]
-		b2 := decodeLookup[src[j+2]]
-		b3 := decodeLookup[src[j+3]]
+		b0 := lookup[src[j]]
+		b1 := lookup[src[j+1]]
+		b2 := lookup[src[j+2]]
+		b3 := lookup[src[j+3]]
 
 		if (b0 | b1 | b2 | b3) >= 64 {
 			return false
@@ -432,8 +636,8 @@ func DecodeBase64URL(dst []byte, src []byte) (ok bool) {
 	}
 
 	// Handle the remaining 2 characters to 1 byte
-	b0 := decodeLookup[src[j]]
-	b1 := decodeLookup[src[j+1]]
+	b0 := lookup[src[j]]
+	b1 := lookup[src[j+1]]
 
 	if (b0 | b1) >= 64 {
 		return false
@@ -443,6 +647,35 @@ func DecodeBase64URL(dst []byte, src []byte) (ok bool) {
 	return true
 }
 
+// DecodeCanonical decodes a canonical hyphenated (8-4-4-4-12) hex src byte slice into a Guid dst byte slice.
+// Accepts upper and lower case hex. Does not panic on invalid input.
+// dst must be at least 16 bytes long and src must be at least 36 bytes long (returns false otherwise).
+// dst is modified even if the function returns false.
+func DecodeCanonical(dst []byte, src []byte) (ok bool) {
+	if (len(dst) < GuidByteSize) || (len(src) < GuidCanonicalByteSize) {
+		return false
+	}
+
+	// Bounds Check Elimination:
+	_ = dst[GuidByteSize-1]
+	_ = src[GuidCanonicalByteSize-1]
+
+	if src[8] != '-' || src[13] != '-' || src[18] != '-' || src[23] != '-' {
+		return false
+	}
+
+	for i, offset := range canonicalHexOffsets {
+		hi := hexDecodeLookup[src[offset]]
+		lo := hexDecodeLookup[src[offset+1]]
+
+		if (hi | lo) >= 16 {
+			return false
+		}
+		dst[i] = (hi << 4) | lo
+	}
+	return true
+}
+
 // Read fills b with cryptographically secure random bytes.
 // It never returns an error, and always fills b entirely.
 // guid.Read() is up to 7x faster than crypto/rand.Read() for small slices.
@@ -512,3 +745,80 @@ var decodeLookup = [256]byte{
 	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
 	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
 }
+
+// decodeLookupStd is a lookup table for decoding standard Base64 characters (RFC 4648 section 4, "+/") to their byte values.
+// Generated the same way as decodeLookup. Values outside the standard Base64 alphabet are marked with 0xFF.
+var decodeLookupStd = [256]byte{
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0x3E, 0xFF, 0xFF, 0xFF, 0x3F,
+	0x34, 0x35, 0x36, 0x37, 0x38, 0x39, 0x3A, 0x3B,
+	0x3C, 0x3D, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06,
+	0x07, 0x08, 0x09, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E,
+	0x0F, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16,
+	0x17, 0x18, 0x19, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x1A, 0x1B, 0x1C, 0x1D, 0x1E, 0x1F, 0x20,
+	0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28,
+	0x29, 0x2A, 0x2B, 0x2C, 0x2D, 0x2E, 0x2F, 0x30,
+	0x31, 0x32, 0x33, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+}
+
+// canonicalHexOffsets holds the position of each Guid byte's 2 hex characters in the canonical 8-4-4-4-12 form.
+var canonicalHexOffsets = [GuidByteSize]byte{0, 2, 4, 6, 9, 11, 14, 16, 19, 21, 24, 26, 28, 30, 32, 34}
+
+// hexDecodeLookup is a lookup table for decoding hex characters (upper and lower case) to their nibble values.
+// Values outside the hex alphabet are marked with 0xFF.
+var hexDecodeLookup = [256]byte{
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07,
+	0x08, 0x09, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x0A, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+}
@@ -50,6 +50,21 @@ func Benchmark_guid_New_x10(b *testing.B) {
 	}
 }
 
+func Benchmark_guid_NewV4_x10(b *testing.B) {
+	for b.Loop() {
+		_ = NewV4()
+		_ = NewV4()
+		_ = NewV4()
+		_ = NewV4()
+		_ = NewV4()
+		_ = NewV4()
+		_ = NewV4()
+		_ = NewV4()
+		_ = NewV4()
+		_ = NewV4()
+	}
+}
+
 func Benchmark_guid_NewPG_x10(b *testing.B) {
 	for b.Loop() {
 		_ = NewPG()
@@ -80,6 +95,51 @@ func Benchmark_guid_NewSS_x10(b *testing.B) {
 	}
 }
 
+func Benchmark_guid_NewMySQL_x10(b *testing.B) {
+	for b.Loop() {
+		_ = NewMySQL()
+		_ = NewMySQL()
+		_ = NewMySQL()
+		_ = NewMySQL()
+		_ = NewMySQL()
+		_ = NewMySQL()
+		_ = NewMySQL()
+		_ = NewMySQL()
+		_ = NewMySQL()
+		_ = NewMySQL()
+	}
+}
+
+func Benchmark_guid_NewV7_x10(b *testing.B) {
+	for b.Loop() {
+		_ = NewV7()
+		_ = NewV7()
+		_ = NewV7()
+		_ = NewV7()
+		_ = NewV7()
+		_ = NewV7()
+		_ = NewV7()
+		_ = NewV7()
+		_ = NewV7()
+		_ = NewV7()
+	}
+}
+
+func Benchmark_guid_NewPGMonotonic_x10(b *testing.B) {
+	for b.Loop() {
+		_ = NewPGMonotonic()
+		_ = NewPGMonotonic()
+		_ = NewPGMonotonic()
+		_ = NewPGMonotonic()
+		_ = NewPGMonotonic()
+		_ = NewPGMonotonic()
+		_ = NewPGMonotonic()
+		_ = NewPGMonotonic()
+		_ = NewPGMonotonic()
+		_ = NewPGMonotonic()
+	}
+}
+
 func Benchmark_guid_New_Parallel_x10(b *testing.B) {
 	b.RunParallel(func(pb *testing.PB) {
 		for pb.Next() {
@@ -388,6 +448,23 @@ func Benchmark_guid_NewPG_Parallel_x10(b *testing.B) {
 	})
 }
 
+func Benchmark_guid_NewV7_Parallel_x10(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_ = NewV7()
+			_ = NewV7()
+			_ = NewV7()
+			_ = NewV7()
+			_ = NewV7()
+			_ = NewV7()
+			_ = NewV7()
+			_ = NewV7()
+			_ = NewV7()
+			_ = NewV7()
+		}
+	})
+}
+
 var benchGuids []Guid
 
 func setupBenchGuids() {
@@ -443,6 +520,72 @@ func Benchmark_base64_RawURLEncoding_Encode_x20(b *testing.B) {
 	}
 }
 
+func Benchmark_guid_AppendText_x20(b *testing.B) {
+	setupBenchGuids()
+	buffer := make([]byte, 0, GuidBase64UrlByteSize)
+	for b.Loop() {
+		for _, g := range benchGuids {
+			buffer, _ = g.AppendText(buffer[:0])
+		}
+	}
+}
+
+func Benchmark_guid_AppendCanonical_x20(b *testing.B) {
+	setupBenchGuids()
+	buffer := make([]byte, 0, GuidCanonicalByteSize)
+	for b.Loop() {
+		for _, g := range benchGuids {
+			buffer = g.AppendCanonical(buffer[:0])
+		}
+	}
+}
+
+func Benchmark_guid_EncodeBase58_x20(b *testing.B) {
+	setupBenchGuids()
+	buffer := make([]byte, GuidBase58ByteSize)
+	for b.Loop() {
+		for _, g := range benchGuids {
+			g.EncodeBase58(buffer)
+		}
+	}
+}
+
+func Benchmark_guid_ParseBase58_x20(b *testing.B) {
+	setupBenchGuids()
+	encoded := make([]string, len(benchGuids))
+	for i := range benchGuids {
+		encoded[i] = benchGuids[i].Base58String()
+	}
+	for b.Loop() {
+		for _, s := range encoded {
+			_, _ = ParseBase58(s)
+		}
+	}
+}
+
+func Benchmark_guid_EncodeCanonical_x20(b *testing.B) {
+	setupBenchGuids()
+	buffer := make([]byte, GuidCanonicalByteSize)
+	for b.Loop() {
+		for _, g := range benchGuids {
+			g.EncodeCanonical(buffer)
+		}
+	}
+}
+
+func Benchmark_guid_ParseCanonical_x20(b *testing.B) {
+	setupBenchGuids()
+	canonicals := make([]string, len(benchGuids))
+	for i := range benchGuids {
+		canonicals[i] = benchGuids[i].CanonicalString()
+	}
+	for b.Loop() {
+		for _, s := range canonicals {
+			_, _ = ParseCanonical(s)
+		}
+	}
+}
+
 func Benchmark_Concurrent_CachePool_GetPut(b *testing.B) {
 	b.ReportAllocs()
 	goroutineCounts := []int{1, 2, 4, 8, 16, 32, 64}
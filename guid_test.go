@@ -2,15 +2,22 @@ package guid
 
 import (
 	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"runtime"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/sdrapkin/guid/guidtest"
 )
 
 //*******************
@@ -918,88 +925,1732 @@ func TestSortableGuids(t *testing.T) {
 	})
 } // TestSortableGuids()
 
-func TestCachePoolGetPut(t *testing.T) {
-	// Test internal func to get 100% code coverage
-	t.Helper()
-	defer func() {
-		if r := recover(); r != nil {
-			t.Errorf("unexpected _CachePool panic: %q", r)
+func TestGuidV7(t *testing.T) {
+	t.Run("layout", func(t *testing.T) {
+		before := time.Now().UTC().Truncate(time.Millisecond)
+		g := NewV7()
+		after := time.Now().UTC()
+		if g.Version() != 7 || g.Variant() != VariantRFC9562 || !g.IsRFC9562() {
+			t.Fatalf("NewV7() = %x: version %d, variant %v", g.Guid, g.Version(), g.Variant())
+		}
+		if ts := g.Timestamp(); ts.Before(before) || ts.After(after) {
+			t.Errorf("NewV7().Timestamp() = %v, want between %v and %v", ts, before, after)
+		}
+	})
+
+	t.Run("RFC 9562 example", func(t *testing.T) {
+		g, _ := ParseCanonical("017f22e2-79b0-7cc3-98c4-dc0c0c07398f")
+		gv7 := GuidV7{Guid: g}
+		want := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+		if ts := gv7.Timestamp().Truncate(time.Millisecond); !ts.Equal(want) {
+			t.Errorf("Timestamp() = %v, want %v", ts, want)
+		}
+	})
+
+	t.Run("timestamp round-trip", func(t *testing.T) {
+		for _, ts := range []int64{0, 1, 244, 245, 999_999, 1_000_000, 1_752_204_767_359_745_700, time.Now().UnixNano()} {
+			g := newV7(v7StateFromUnixNano(ts))
+			got := g.Timestamp().UnixNano()
+			if got > ts || ts-got >= 245 {
+				t.Errorf("newV7(%d).Timestamp() = %d, want within 244ns", ts, got)
+			}
+			if g2 := newV7(v7StateFromUnixNano(got)); g2.state() != g.state() {
+				t.Errorf("state round-trip mismatch for %d: %x vs %x", ts, g.state(), g2.state())
+			}
+		}
+	})
+
+	t.Run("monotonic", func(t *testing.T) {
+		prev := NewV7()
+		for range 100_000 {
+			g := NewV7()
+			if bytes.Compare(prev.Guid[:], g.Guid[:]) >= 0 {
+				t.Fatalf("NewV7() not strictly increasing:\nprev: %x\ng:    %x", prev.Guid, g.Guid)
+			}
+			prev = g
+		}
+	})
+
+	t.Run("monotonic under backward clock", func(t *testing.T) {
+		now := time.Now().UnixNano()
+		g1 := newV7(v7State.next(now))
+		g2 := newV7(v7State.next(now - int64(time.Hour)))
+		if bytes.Compare(g1.Guid[:], g2.Guid[:]) >= 0 {
+			t.Errorf("NewV7() went backwards with the clock:\ng1: %x\ng2: %x", g1.Guid, g2.Guid)
+		}
+	})
+
+	t.Run("monotonic in parallel", func(t *testing.T) {
+		numCPUs := runtime.NumCPU()
+		results := make([][]GuidV7, numCPUs)
+		var wg sync.WaitGroup
+		for i := range numCPUs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				guids := make([]GuidV7, 10_000)
+				for j := range guids {
+					guids[j] = NewV7()
+				}
+				results[i] = guids
+			}()
+		}
+		wg.Wait()
+
+		all := make([]Guid, 0, numCPUs*10_000)
+		for _, guids := range results {
+			for j := 1; j < len(guids); j++ {
+				if bytes.Compare(guids[j-1].Guid[:], guids[j].Guid[:]) >= 0 {
+					t.Fatalf("NewV7() not strictly increasing within a goroutine")
+				}
+			}
+			for _, g := range guids {
+				all = append(all, g.Guid)
+			}
+		}
+		if duplicatesFound(all) {
+			t.Error("Duplicate GuidV7 found")
+		}
+	})
+
+	t.Run("GuidPG conversions", func(t *testing.T) {
+		gv7 := NewV7()
+		gpg := gv7.ToPG()
+		if !gpg.Timestamp().Equal(gv7.Timestamp()) {
+			t.Errorf("ToPG() timestamp %v, want %v", gpg.Timestamp(), gv7.Timestamp())
+		}
+		if !bytes.Equal(gpg.Guid[8:], gv7.Guid[8:]) {
+			t.Errorf("ToPG() did not keep the last 8 bytes")
+		}
+		if back := gpg.ToV7(); back != gv7 {
+			t.Errorf("ToPG().ToV7() = %x, want %x", back.Guid, gv7.Guid)
+		}
+
+		gpg = NewPG()
+		gv7 = gpg.ToV7()
+		if gv7.Version() != 7 || gv7.Variant() != VariantRFC9562 {
+			t.Errorf("ToV7() = %x lacks version/variant", gv7.Guid)
+		}
+		if d := gpg.Timestamp().Sub(gv7.Timestamp()); d < 0 || d >= 245 {
+			t.Errorf("ToV7() timestamp %v, want within 244ns of %v", gv7.Timestamp(), gpg.Timestamp())
+		}
+		if !bytes.Equal(gpg.Guid[9:], gv7.Guid[9:]) || (gpg.Guid[8]^gv7.Guid[8])&0x3F != 0 {
+			t.Errorf("ToV7() did not keep the random bits")
+		}
+	})
+}
+
+func TestNameBasedGuids(t *testing.T) {
+	testcases := []struct {
+		name      string
+		generate  func(Guid, []byte) Guid
+		namespace Guid
+		input     string
+		want      string
+	}{
+		{"V5 DNS (RFC 9562 example)", NewV5, NamespaceDNS, "www.example.com", "2ed6657d-e927-568b-95e1-2665a8aea6a2"},
+		{"V3 DNS (RFC 9562 example)", NewV3, NamespaceDNS, "www.example.com", "5df41881-3aed-3515-88a7-2f4a814cf09e"},
+		{"V5 URL", NewV5, NamespaceURL, "https://go.dev", "76d71d1b-203b-51c3-b17d-c090cb0e4e32"},
+		{"V5 long name", NewV5, NamespaceOID, strings.Repeat("x", 1000), "0ee7e31f-a49b-55bf-a896-2c72533f360c"},
+		{"V3 long name", NewV3, NamespaceX500, strings.Repeat("x", 1000), "61852423-f841-37a1-aa7f-8d0c2c1f8794"},
+	}
+	for _, tc := range testcases {
+		g := tc.generate(tc.namespace, []byte(tc.input))
+		if s := g.CanonicalString(); s != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, s, tc.want)
+		}
+		if !g.IsRFC9562() {
+			t.Errorf("%s: %x is not an RFC 9562 Guid", tc.name, g)
 		}
-	}()
-	for range 10 {
-		_CachePool_GetPut()
 	}
+
+	t.Run("stack and heap paths agree", func(t *testing.T) {
+		name := []byte(strings.Repeat("y", maxStackName+1))
+		for _, n := range []int{maxStackName, maxStackName + 1} {
+			h5 := newNameBasedHash(sha1.New(), NamespaceDNS, name[:n], 5)
+			h3 := newNameBasedHash(md5.New(), NamespaceDNS, name[:n], 3)
+			if g := NewV5(NamespaceDNS, name[:n]); g != h5 {
+				t.Errorf("NewV5 mismatch for %d-byte name", n)
+			}
+			if g := NewV3(NamespaceDNS, name[:n]); g != h3 {
+				t.Errorf("NewV3 mismatch for %d-byte name", n)
+			}
+		}
+	})
+
+	t.Run("no allocations for short names", func(t *testing.T) {
+		name := []byte("tenant-42/user@example.com")
+		if allocs := testing.AllocsPerRun(100, func() { _ = NewV5(NamespaceURL, name) }); allocs != 0 {
+			t.Errorf("NewV5 allocated %v times", allocs)
+		}
+		if allocs := testing.AllocsPerRun(100, func() { _ = NewV3(NamespaceURL, name) }); allocs != 0 {
+			t.Errorf("NewV3 allocated %v times", allocs)
+		}
+	})
 }
 
-func FuzzParse(f *testing.F) {
-	// Add some valid and invalid seed cases
-	f.Add("AAAAAAAAAAAAAAAAAAAAAA")   // valid (Nil)
-	f.Add("_____________________w")   // valid
-	f.Add("not-a-guid")               // invalid
-	f.Add("")                         // invalid
-	f.Add("AAAAAAAAAAAAAAAAAAAAAA==") // invalid (with padding)
-	f.Add("1234567890123456789012")   // invalid (wrong chars)
-	f.Add("!@#$%^&*()_+{}|")          // invalid (wrong chars, wrong length)
-	f.Add("こんaにちは世ち")                 // unicode with len=22
+func TestGuidV8(t *testing.T) {
+	t.Run("NewV8", func(t *testing.T) {
+		for _, custom := range [][16]byte{Nil, Max, New()} {
+			g := NewV8(custom)
+			if g.Version() != 8 || g.Variant() != VariantRFC9562 || !g.IsRFC9562() {
+				t.Errorf("NewV8(%x) = %x lacks version/variant", custom, g)
+			}
+			g[6], g[8] = custom[6]&0xF0|g[6]&0x0F, custom[8]&0xC0|g[8]&0x3F
+			if g != custom {
+				t.Errorf("NewV8(%x) modified non-reserved bits", custom)
+			}
+		}
+	})
 
-	f.Fuzz(func(t *testing.T, s string) {
-		g, err := Parse(s)
+	t.Run("layout validation", func(t *testing.T) {
+		invalid := [][]V8Field{
+			{{Name: "", Offset: 0, Width: 8}},
+			{{Name: "a", Offset: 0, Width: 8}, {Name: "a", Offset: 8, Width: 8}},
+			{{Name: "zero", Offset: 0, Width: 0}},
+			{{Name: "wide", Offset: 0, Width: 65}},
+			{{Name: "past end", Offset: 120, Width: 9}},
+			{{Name: "version", Offset: 40, Width: 9}},
+			{{Name: "variant", Offset: 65, Width: 8}},
+			{{Name: "a", Offset: 0, Width: 16}, {Name: "b", Offset: 15, Width: 8}},
+		}
+		for _, fields := range invalid {
+			if _, err := NewV8Layout(fields...); !errors.Is(err, ErrInvalidV8Layout) {
+				t.Errorf("NewV8Layout(%+v) error = %v, want ErrInvalidV8Layout", fields, err)
+			}
+		}
+	})
+
+	t.Run("pack and unpack", func(t *testing.T) {
+		fields := []V8Field{
+			{Name: "shard", Offset: 0, Width: 10},
+			{Name: "timestamp", Offset: 10, Width: 38},
+			{Name: "sequence", Offset: 52, Width: 12},
+			{Name: "node", Offset: 66, Width: 62},
+		}
+		layout, err := NewV8Layout(fields...)
 		if err != nil {
-			// If Parse fails, that's fine for invalid input
-			return
+			t.Fatalf("NewV8Layout failed: %v", err)
 		}
-		// If Parse succeeds, the string must be 22 chars and must round-trip
-		if len(s) != GuidBase64UrlByteSize {
-			t.Errorf("Parse succeeded for string of wrong length: %q", s)
+		if got := layout.Fields(); !reflect.DeepEqual(got, fields) {
+			t.Errorf("Fields() = %+v, want %+v", got, fields)
 		}
-		// Round-trip: g.String() should equal s (case for valid input)
-		s2 := g.String()
-		g2, err2 := Parse(s2)
-		if err2 != nil {
-			t.Errorf("Parse failed on round-trip string: %q", s2)
+
+		values := map[string]uint64{"shard": 0x3FF, "timestamp": 0x12_3456_789A, "sequence": 0xABC, "node": 1<<62 - 1}
+		b := layout.NewBuilder()
+		for name, value := range values {
+			b.Set(name, value)
 		}
-		if g != g2 {
-			t.Errorf("Round-trip mismatch: got %v, want %v", g2, g)
+		g, err := b.Build()
+		if err != nil {
+			t.Fatalf("Build failed: %v", err)
+		}
+		if g.Version() != 8 || g.Variant() != VariantRFC9562 {
+			t.Errorf("Build() = %x lacks version/variant", g)
+		}
+		for name, want := range values {
+			if got, err := layout.Get(g, name); err != nil || got != want {
+				t.Errorf("Get(%q) = %#x, %v; want %#x", name, got, err, want)
+			}
+		}
+		if _, err := layout.Get(g, "missing"); !errors.Is(err, ErrUnknownV8Field) {
+			t.Errorf("Get(missing) error = %v, want ErrUnknownV8Field", err)
+		}
+
+		// set fields are overwritten, fields not set are random
+		g2, _ := layout.NewBuilder().Set("shard", 1).Set("shard", 2).Build()
+		if shard, _ := layout.Get(g2, "shard"); shard != 2 {
+			t.Errorf("Get(shard) = %d, want 2", shard)
+		}
+	})
+
+	t.Run("fresh random bits per Build", func(t *testing.T) {
+		layout, _ := NewV8Layout(V8Field{Name: "shard", Offset: 0, Width: 16})
+		b := layout.NewBuilder().Set("shard", 0xABCD)
+		g1, _ := b.Build()
+		g2, _ := b.Build()
+		for _, g := range []Guid{g1, g2} {
+			if shard, _ := layout.Get(g, "shard"); shard != 0xABCD || g.Version() != 8 || g.Variant() != VariantRFC9562 {
+				t.Fatalf("Build() = %x, want shard 0xabcd with version/variant", g)
+			}
+		}
+		if [14]byte(g1[2:]) == [14]byte(g2[2:]) {
+			t.Errorf("two Builds share the unset bits: %x, %x", g1, g2)
+		}
+	})
+
+	t.Run("builder errors", func(t *testing.T) {
+		layout, _ := NewV8Layout(V8Field{Name: "shard", Offset: 0, Width: 4})
+		if _, err := layout.NewBuilder().Set("shard", 16).Build(); !errors.Is(err, ErrV8FieldOverflow) {
+			t.Errorf("Build() error = %v, want ErrV8FieldOverflow", err)
+		}
+		if _, err := layout.NewBuilder().Set("missing", 1).Set("shard", 1).Build(); !errors.Is(err, ErrUnknownV8Field) {
+			t.Errorf("Build() error = %v, want ErrUnknownV8Field", err)
+		}
+
+		var zero V8Builder
+		if _, err := zero.Build(); !errors.Is(err, ErrInvalidV8Layout) {
+			t.Errorf("zero V8Builder Build() error = %v, want ErrInvalidV8Layout", err)
+		}
+		if _, err := zero.Set("shard", 1).Build(); !errors.Is(err, ErrInvalidV8Layout) {
+			t.Errorf("zero V8Builder Set().Build() error = %v, want ErrInvalidV8Layout", err)
 		}
 	})
 }
 
-func FuzzParseBytes(f *testing.F) {
-	f.Add([]byte("AAAAAAAAAAAAAAAAAAAAAA"))   // valid
-	f.Add([]byte("not-a-guid"))               // invalid
-	f.Add([]byte(""))                         // invalid
-	f.Add([]byte("AAAAAAAAAAAAAAAAAAAAAA==")) // invalid
-	f.Add([]byte("1234567890123456789012"))   // invalid
-	f.Add([]byte("!@#$%^&*()_+{}|"))          // invalid
-	f.Add([]byte("こんaにちは世ち"))                 // unicode
+// compareSS compares two Guids the way SQL Server orders uniqueidentifier values:
+// bytes 10-15 first, then 8-9, 6-7, 4-5 and finally 0-3.
+func compareSS(a, b Guid) int {
+	for _, r := range [][2]int{{10, 16}, {8, 10}, {6, 8}, {4, 6}, {0, 4}} {
+		if c := bytes.Compare(a[r[0]:r[1]], b[r[0]:r[1]]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
 
-	f.Fuzz(func(t *testing.T, b []byte) {
-		g, err := ParseBytes(b)
-		if err != nil {
-			return
+func TestMonotonicSequentialGuids(t *testing.T) {
+	t.Run("sequential", func(t *testing.T) {
+		prevPG, prevSS := NewPGMonotonic(), NewSSMonotonic()
+		for range 100_000 {
+			gpg, gss := NewPGMonotonic(), NewSSMonotonic()
+			if bytes.Compare(prevPG.Guid[:], gpg.Guid[:]) >= 0 {
+				t.Fatalf("NewPGMonotonic() not strictly increasing:\nprev: %x\ng:    %x", prevPG.Guid, gpg.Guid)
+			}
+			if compareSS(prevSS.Guid, gss.Guid) >= 0 {
+				t.Fatalf("NewSSMonotonic() not strictly increasing:\nprev: %x\ng:    %x", prevSS.Guid, gss.Guid)
+			}
+			prevPG, prevSS = gpg, gss
 		}
-		if len(b) != GuidBase64UrlByteSize {
-			t.Errorf("ParseBytes succeeded for slice of wrong length: %q", b)
+	})
+
+	t.Run("backward clock", func(t *testing.T) {
+		var m monotonicTimestamp
+		now := time.Now().UnixNano()
+		ts := []int64{m.next(now), m.next(now), m.next(now - int64(time.Hour)), m.next(now + 10)}
+		want := []int64{now, now + 1, now + 2, now + 10}
+		if !reflect.DeepEqual(ts, want) {
+			t.Errorf("monotonicTimestamp.next() = %v, want %v", ts, want)
 		}
-		s := g.String()
-		g2, err2 := Parse(s)
-		if err2 != nil {
-			t.Errorf("Parse failed on round-trip string: %q", s)
+	})
+
+	t.Run("parallel", func(t *testing.T) {
+		numCPUs := runtime.NumCPU()
+		results := make([][]GuidPG, numCPUs)
+		var wg sync.WaitGroup
+		for i := range numCPUs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				guids := make([]GuidPG, 10_000)
+				for j := range guids {
+					guids[j] = NewPGMonotonic()
+				}
+				results[i] = guids
+			}()
 		}
-		if g != g2 {
-			t.Errorf("Round-trip mismatch: got %v, want %v", g2, g)
+		wg.Wait()
+
+		seen := make(map[int64]struct{}, numCPUs*10_000)
+		for _, guids := range results {
+			for j, g := range guids {
+				if j > 0 && bytes.Compare(guids[j-1].Guid[:], g.Guid[:]) >= 0 {
+					t.Fatalf("NewPGMonotonic() not strictly increasing within a goroutine")
+				}
+				seen[g.Timestamp().UnixNano()] = struct{}{}
+			}
+		}
+		if len(seen) != numCPUs*10_000 {
+			t.Errorf("NewPGMonotonic() issued %d duplicate timestamps", numCPUs*10_000-len(seen))
 		}
 	})
 }
 
-func ExampleNew() {
-	g := New()      // new random Guid
-	fmt.Println(&g) // calls g.String(), which returns the Base64Url encoded string
+func TestNewPGAtAndNewSSAt(t *testing.T) {
+	times := []time.Time{
+		time.Date(1999, 12, 31, 23, 59, 59, 999_999_999, time.UTC),
+		time.Date(2001, 9, 9, 1, 46, 40, 0, time.UTC),
+		time.Date(2024, 2, 29, 12, 0, 0, 1, time.FixedZone("UTC+5", 5*3600)),
+		time.Date(2024, 2, 29, 12, 0, 0, 2, time.FixedZone("UTC+5", 5*3600)),
+		time.Now(),
+	}
+	for i, tm := range times {
+		gpg, gss := NewPGAt(tm), NewSSAt(tm)
+		if ts := gpg.Timestamp(); !ts.Equal(tm) || ts.Location() != time.UTC {
+			t.Errorf("NewPGAt(%v).Timestamp() = %v", tm, ts)
+		}
+		if ts := gss.Timestamp(); !ts.Equal(tm) || ts.Location() != time.UTC {
+			t.Errorf("NewSSAt(%v).Timestamp() = %v", tm, ts)
+		}
+		if i > 0 {
+			prevPG, prevSS := NewPGAt(times[i-1]), NewSSAt(times[i-1])
+			if bytes.Compare(prevPG.Guid[:], gpg.Guid[:]) >= 0 {
+				t.Errorf("NewPGAt(%v) does not sort after NewPGAt(%v)", tm, times[i-1])
+			}
+			if compareSS(prevSS.Guid, gss.Guid) >= 0 {
+				t.Errorf("NewSSAt(%v) does not sort after NewSSAt(%v)", tm, times[i-1])
+			}
+		}
+	}
+	if g1, g2 := NewPGAt(times[0]), NewPGAt(times[0]); g1 == g2 {
+		t.Error("NewPGAt() with the same time should still produce different Guids")
+	}
+
+	// pre-1970 timestamps round-trip, but are negative and sort after every post-1970 Guid (see NewPGAt)
+	epoch, before := time.Unix(0, 0), time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC)
+	gpg, gss := NewPGAt(before), NewSSAt(before)
+	if !gpg.Timestamp().Equal(before) || !gss.Timestamp().Equal(before) {
+		t.Errorf("NewPGAt/NewSSAt(%v).Timestamp() = %v, %v", before, gpg.Timestamp(), gss.Timestamp())
+	}
+	if epochPG := NewPGAt(epoch); bytes.Compare(gpg.Guid[:], epochPG.Guid[:]) <= 0 {
+		t.Errorf("NewPGAt(%v) unexpectedly sorts before NewPGAt(%v)", before, epoch)
+	}
+	if epochSS := NewSSAt(epoch); compareSS(gss.Guid, epochSS.Guid) <= 0 {
+		t.Errorf("NewSSAt(%v) unexpectedly sorts before NewSSAt(%v)", before, epoch)
+	}
 }
 
-func ExampleGuid_String() {
-	// g is a 16-byte Guid represented as a hex string "0123456789abcdef0123456789abcdef"
-	var g Guid = [16]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x10, 0x32, 0x54, 0x76, 0x98, 0xba, 0xdc, 0xfe}
-	fmt.Println(&g) // calls g.String(), which returns the Base64Url encoded string
-	// Output: ASNFZ4mrze8QMlR2mLrc_g
+func TestTimeRangeBoundaries(t *testing.T) {
+	t1 := time.Date(2025, 7, 11, 3, 32, 47, 359_745_700, time.UTC)
+	t2 := t1.Add(time.Second)
+
+	minPG, maxPG := MinPGForTime(t1), MaxPGForTime(t1)
+	if !minPG.Timestamp().Equal(t1) || !maxPG.Timestamp().Equal(t1) {
+		t.Errorf("PG bounds timestamps = %v, %v; want %v", minPG.Timestamp(), maxPG.Timestamp(), t1)
+	}
+	minSS, maxSS := MinSSForTime(t1), MaxSSForTime(t1)
+	if !minSS.Timestamp().Equal(t1) || !maxSS.Timestamp().Equal(t1) {
+		t.Errorf("SS bounds timestamps = %v, %v; want %v", minSS.Timestamp(), maxSS.Timestamp(), t1)
+	}
+
+	for range 1000 {
+		gpg, gss := NewPGAt(t1), NewSSAt(t1)
+		if bytes.Compare(minPG.Guid[:], gpg.Guid[:]) > 0 || bytes.Compare(gpg.Guid[:], maxPG.Guid[:]) > 0 {
+			t.Fatalf("GuidPG %x is outside of [%x, %x]", gpg.Guid, minPG.Guid, maxPG.Guid)
+		}
+		if compareSS(minSS.Guid, gss.Guid) > 0 || compareSS(gss.Guid, maxSS.Guid) > 0 {
+			t.Fatalf("GuidSS %x is outside of [%x, %x]", gss.Guid, minSS.Guid, maxSS.Guid)
+		}
+	}
+
+	// Guids 1ns outside of the range must fall outside of the bounds
+	loPG, hiPG := PGRange(t1, t2)
+	loSS, hiSS := SSRange(t1, t2)
+	if loPG != minPG || hiPG != MaxPGForTime(t2) || loSS != minSS || hiSS != MaxSSForTime(t2) {
+		t.Error("PGRange/SSRange do not match the Min/Max bounds")
+	}
+	before, after := t1.Add(-time.Nanosecond), t2.Add(time.Nanosecond)
+	if g := MaxPGForTime(before); bytes.Compare(g.Guid[:], loPG.Guid[:]) >= 0 {
+		t.Errorf("GuidPG at %v is inside of the range", before)
+	}
+	if g := MinPGForTime(after); bytes.Compare(g.Guid[:], hiPG.Guid[:]) <= 0 {
+		t.Errorf("GuidPG at %v is inside of the range", after)
+	}
+	if g := MaxSSForTime(before); compareSS(g.Guid, loSS.Guid) >= 0 {
+		t.Errorf("GuidSS at %v is inside of the range", before)
+	}
+	if g := MinSSForTime(after); compareSS(g.Guid, hiSS.Guid) <= 0 {
+		t.Errorf("GuidSS at %v is inside of the range", after)
+	}
+
+	// ranges before 1970 work, ranges across 1970 are inverted (see PGRange)
+	y1950, y1960, y1980 := time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+	loPG, hiPG = PGRange(y1950, y1960)
+	loSS, hiSS = SSRange(y1950, y1960)
+	if bytes.Compare(loPG.Guid[:], hiPG.Guid[:]) >= 0 || compareSS(loSS.Guid, hiSS.Guid) >= 0 {
+		t.Errorf("PGRange/SSRange(%v, %v) are inverted", y1950, y1960)
+	}
+	loPG, hiPG = PGRange(y1960, y1980)
+	loSS, hiSS = SSRange(y1960, y1980)
+	if bytes.Compare(loPG.Guid[:], hiPG.Guid[:]) <= 0 || compareSS(loSS.Guid, hiSS.Guid) <= 0 {
+		t.Errorf("PGRange/SSRange(%v, %v) are unexpectedly ordered", y1960, y1980)
+	}
+}
+
+func TestSequentialGenerator(t *testing.T) {
+	start := time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)
+	clock := guidtest.NewFakeClock(start)
+	gen := NewSequentialGenerator(clock)
+
+	gpg, gss, gv7 := gen.NewPG(), gen.NewSS(), gen.NewV7()
+	if ts := gpg.Timestamp(); !ts.Equal(start) {
+		t.Errorf("NewPG().Timestamp() = %v, want %v", ts, start)
+	}
+	if ts := gss.Timestamp(); !ts.Equal(start) {
+		t.Errorf("NewSS().Timestamp() = %v, want %v", ts, start)
+	}
+	if ts := gv7.Timestamp(); ts.After(start) || start.Sub(ts) >= 245 {
+		t.Errorf("NewV7().Timestamp() = %v, want %v", ts, start)
+	}
+
+	t.Run("monotonic with a stopped clock", func(t *testing.T) {
+		gen := NewSequentialGenerator(guidtest.NewFakeClock(start))
+		for i := range 10 {
+			want := start.Add(time.Duration(i))
+			if g := gen.NewPGMonotonic(); !g.Timestamp().Equal(want) {
+				t.Errorf("NewPGMonotonic() #%d timestamp = %v, want %v", i, g.Timestamp(), want)
+			}
+		}
+		if g := gen.NewSSMonotonic(); !g.Timestamp().Equal(start.Add(10)) {
+			t.Errorf("NewSSMonotonic() timestamp = %v, want %v", g.Timestamp(), start.Add(10))
+		}
+		prev := gen.NewV7()
+		for range 10 {
+			g := gen.NewV7()
+			if bytes.Compare(prev.Guid[:], g.Guid[:]) >= 0 {
+				t.Fatalf("NewV7() not strictly increasing with a stopped clock")
+			}
+			prev = g
+		}
+	})
+
+	t.Run("backward clock jump", func(t *testing.T) {
+		clock := guidtest.NewFakeClock(start)
+		gen := NewSequentialGenerator(clock)
+		g1 := gen.NewPGMonotonic()
+		clock.Advance(-time.Hour)
+		g2 := gen.NewPGMonotonic()
+		if bytes.Compare(g1.Guid[:], g2.Guid[:]) >= 0 {
+			t.Errorf("NewPGMonotonic() went backwards with the clock")
+		}
+		if g := gen.NewPG(); !g.Timestamp().Equal(start.Add(-time.Hour)) {
+			t.Errorf("NewPG() should follow the clock: %v", g.Timestamp())
+		}
+		clock.Set(start.Add(time.Hour))
+		if g := gen.NewPGMonotonic(); !g.Timestamp().Equal(start.Add(time.Hour)) {
+			t.Errorf("NewPGMonotonic() should follow the clock forward: %v", g.Timestamp())
+		}
+	})
+
+	t.Run("nil clock", func(t *testing.T) {
+		before := time.Now()
+		g := NewSequentialGenerator(nil).NewPG()
+		ts := g.Timestamp()
+		if ts.Before(before) || ts.After(time.Now()) {
+			t.Errorf("NewSequentialGenerator(nil) does not use the system clock: %v", ts)
+		}
+	})
+
+	t.Run("SystemClock", func(t *testing.T) {
+		before := time.Now()
+		g := NewSequentialGenerator(SystemClock()).NewPG()
+		if ts := g.Timestamp(); ts.Before(before) || ts.After(time.Now()) {
+			t.Errorf("SystemClock() is not time.Now(): %v", ts)
+		}
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		var gen SequentialGenerator
+		before := time.Now()
+		gpg, gss := gen.NewPG(), gen.NewSSMonotonic()
+		v1, v2 := gen.NewV7(), gen.NewV7()
+		after := time.Now()
+		for _, ts := range []time.Time{gpg.Timestamp(), gss.Timestamp()} {
+			if ts.Before(before) || ts.After(after) {
+				t.Errorf("zero SequentialGenerator does not use the system clock: %v", ts)
+			}
+		}
+		if bytes.Compare(v1.Guid[:], v2.Guid[:]) >= 0 {
+			t.Errorf("zero SequentialGenerator NewV7() = %x, %x; not increasing", v1, v2)
+		}
+	})
+}
+
+func TestPGSSConversions(t *testing.T) {
+	for range 1000 {
+		gpg := NewPG()
+		gss := gpg.ToSS()
+		if !gss.Timestamp().Equal(gpg.Timestamp()) || !bytes.Equal(gss.Guid[:8], gpg.Guid[8:]) {
+			t.Fatalf("ToSS() = %x does not keep the timestamp and random bytes of %x", gss.Guid, gpg.Guid)
+		}
+		if back := gss.ToPG(); back != gpg {
+			t.Fatalf("ToSS().ToPG() = %x, want %x", back.Guid, gpg.Guid)
+		}
+
+		gss = NewSS()
+		gpg = gss.ToPG()
+		if back := gpg.ToSS(); back != gss {
+			t.Fatalf("ToPG().ToSS() = %x, want %x", back.Guid, gss.Guid)
+		}
+	}
+
+	// time order is preserved on both sides
+	t1 := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, d := range []time.Duration{1, time.Microsecond, time.Second, 24 * time.Hour} {
+		gpg1, gpg2 := NewPGAt(t1), NewPGAt(t1.Add(d))
+		if g1, g2 := gpg1.ToSS(), gpg2.ToSS(); compareSS(g1.Guid, g2.Guid) >= 0 {
+			t.Errorf("ToSS() does not preserve time order for %v", d)
+		}
+		gss1, gss2 := NewSSAt(t1), NewSSAt(t1.Add(d))
+		if g1, g2 := gss1.ToPG(), gss2.ToPG(); bytes.Compare(g1.Guid[:], g2.Guid[:]) >= 0 {
+			t.Errorf("ToPG() does not preserve time order for %v", d)
+		}
+	}
+}
+
+func TestMicrosoftBytes(t *testing.T) {
+	// .NET: new Guid("00112233-4455-6677-8899-aabbccddeeff").ToByteArray()
+	g, _ := ParseCanonical("00112233-4455-6677-8899-aabbccddeeff")
+	ms := [16]byte{0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}
+	if got := g.ToMicrosoftBytes(); got != ms {
+		t.Errorf("ToMicrosoftBytes() = %x, want %x", got, ms)
+	}
+	if got, err := FromMicrosoftBytes(ms[:]); err != nil || got != g {
+		t.Errorf("FromMicrosoftBytes() = %x, %v; want %x", got, err, g)
+	}
+	if _, err := FromMicrosoftBytes(ms[:15]); err != ErrInvalidGuidSlice {
+		t.Errorf("FromMicrosoftBytes(15 bytes) error = %v, want ErrInvalidGuidSlice", err)
+	}
+
+	// GuidSS bytes are SQL Server storage bytes
+	gss := GuidSS{Guid: ms}
+	if s := gss.SQLServerString(); s != "00112233-4455-6677-8899-aabbccddeeff" {
+		t.Errorf("SQLServerString() = %q", s)
+	}
+	if got, err := ParseSQLServerString("00112233-4455-6677-8899-AABBCCDDEEFF"); err != nil || got != gss {
+		t.Errorf("ParseSQLServerString() = %x, %v; want %x", got.Guid, err, gss.Guid)
+	}
+	if _, err := ParseSQLServerString("00112233-4455-6677-8899-aabbccddeefg"); err == nil {
+		t.Error("ParseSQLServerString should fail on invalid input")
+	}
+
+	t.Run("GuidSS Scan and Value", func(t *testing.T) {
+		for range 100 {
+			gss := NewSS()
+			v, _ := gss.Value()
+			inputs := []any{gss.Guid[:], v, []byte(v.(string)), strings.ToUpper(v.(string)), gss.String()}
+			for _, src := range inputs {
+				var scanned GuidSS
+				if err := scanned.Scan(src); err != nil || scanned != gss {
+					t.Fatalf("GuidSS.Scan(%v) = %x, %v; want %x", src, scanned.Guid, err, gss.Guid)
+				}
+				var n NullGuidSS
+				if err := n.Scan(src); err != nil || !n.Valid || n.GuidSS != gss {
+					t.Fatalf("NullGuidSS.Scan(%v) = %+v, %v; want %x", src, n, err, gss.Guid)
+				}
+			}
+		}
+		gss := NewSS()
+		if err := gss.Scan(nil); err != nil || gss.Guid != Nil {
+			t.Errorf("GuidSS.Scan(nil) = %x, %v; want Nil", gss.Guid, err)
+		}
+		for _, src := range []any{42, "00112233-4455-6677-8899-aabbccddeefg", []byte("short")} {
+			if err := gss.Scan(src); err == nil {
+				t.Errorf("GuidSS.Scan(%v) should fail", src)
+			}
+		}
+	})
+}
+
+func TestGuidMySQL(t *testing.T) {
+	t.Run("UUID_TO_BIN swap (MySQL manual example)", func(t *testing.T) {
+		var gm GuidMySQL
+		if err := gm.Scan("6ccd780c-baba-1026-9564-5b8c656024db"); err != nil {
+			t.Fatalf("Scan failed: %v", err)
+		}
+		if h := hex.EncodeToString(gm.Guid[:]); h != "1026baba6ccd780c95645b8c656024db" {
+			t.Errorf("UUID_TO_BIN(uuid, 1) = %s, want 1026baba6ccd780c95645b8c656024db", h)
+		}
+		v1 := gm.UUIDv1()
+		if s := v1.CanonicalString(); s != "6ccd780c-baba-1026-9564-5b8c656024db" {
+			t.Errorf("UUIDv1() = %s", s)
+		}
+	})
+
+	t.Run("layout", func(t *testing.T) {
+		before := time.Now().UTC()
+		gm := NewMySQL()
+		after := time.Now().UTC()
+		if ts := gm.Timestamp(); ts.Before(before.Truncate(100)) || ts.After(after) {
+			t.Errorf("NewMySQL().Timestamp() = %v, want between %v and %v", ts, before, after)
+		}
+		v1 := gm.UUIDv1()
+		if v1.Version() != 1 || v1.Variant() != VariantRFC9562 || v1[10]&0x01 == 0 {
+			t.Errorf("UUIDv1() = %x is not a version 1 uuid with a random node", v1)
+		}
+	})
+
+	t.Run("timestamp", func(t *testing.T) {
+		tm := time.Date(2025, 7, 11, 3, 32, 47, 359_745_700, time.UTC)
+		gm := newMySQL(tm.UnixNano())
+		if ts := gm.Timestamp(); !ts.Equal(tm) {
+			t.Errorf("Timestamp() = %v, want %v", ts, tm)
+		}
+		// RFC 9562 version 1 example: C232AB00-9414-11EC-B3C8-9F6BDECED846 is 2022-02-22 19:22:22 UTC
+		v1, _ := ParseCanonical("c232ab00-9414-11ec-b3c8-9f6bdeced846")
+		gm = fromUUIDv1(v1)
+		if ts := gm.Timestamp(); !ts.Equal(time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)) {
+			t.Errorf("RFC 9562 v1 example Timestamp() = %v", ts)
+		}
+	})
+
+	t.Run("sortable", func(t *testing.T) {
+		t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		for _, d := range []time.Duration{100, time.Microsecond, time.Second, time.Hour, 365 * 24 * time.Hour} {
+			g1, g2 := newMySQL(t1.UnixNano()), newMySQL(t1.Add(d).UnixNano())
+			if bytes.Compare(g1.Guid[:], g2.Guid[:]) >= 0 {
+				t.Errorf("GuidMySQL is not sortable for %v:\ng1: %x\ng2: %x", d, g1.Guid, g2.Guid)
+			}
+		}
+	})
+
+	t.Run("Scan and Value", func(t *testing.T) {
+		gm := NewMySQL()
+		v, err := gm.Value()
+		if err != nil || !bytes.Equal(v.([]byte), gm.Guid[:]) {
+			t.Errorf("Value() = %v, %v; want raw bytes", v, err)
+		}
+		v1 := gm.UUIDv1()
+		for _, src := range []any{gm.Guid[:], gm.String(), v1.CanonicalString(), []byte(strings.ToUpper(v1.CanonicalString()))} {
+			var scanned GuidMySQL
+			if err := scanned.Scan(src); err != nil || scanned != gm {
+				t.Errorf("Scan(%v) = %x, %v; want %x", src, scanned.Guid, err, gm.Guid)
+			}
+		}
+		if err := gm.Scan("6ccd780c-baba-1026-9564-5b8c656024dx"); err == nil {
+			t.Error("Scan should fail on invalid canonical text")
+		}
+		if err := gm.Scan(nil); err != nil || gm.Guid != Nil {
+			t.Errorf("Scan(nil) = %x, %v; want Nil", gm.Guid, err)
+		}
+	})
+}
+
+func TestMsgpackAndCBOR(t *testing.T) {
+	g, _ := ParseCanonical("00112233-4455-6677-8899-aabbccddeeff")
+
+	mp, err := g.MarshalMsgpack()
+	if err != nil || !bytes.Equal(mp, append([]byte{0xc4, 0x10}, g[:]...)) {
+		t.Fatalf("MarshalMsgpack() = %x, %v", mp, err)
+	}
+	cb, err := g.MarshalCBOR()
+	if err != nil || !bytes.Equal(cb, append([]byte{0xd8, 0x25, 0x50}, g[:]...)) {
+		t.Fatalf("MarshalCBOR() = %x, %v", cb, err)
+	}
+
+	for range 100 {
+		gpg, gss := NewPG(), NewSS()
+		mp, _ := gpg.MarshalMsgpack()
+		var pg GuidPG
+		if err := pg.UnmarshalMsgpack(mp); err != nil || pg != gpg {
+			t.Fatalf("GuidPG msgpack round-trip = %x, %v; want %x", pg.Guid, err, gpg.Guid)
+		}
+		cb, _ := gss.MarshalCBOR()
+		var ss GuidSS
+		if err := ss.UnmarshalCBOR(cb); err != nil || ss != gss {
+			t.Fatalf("GuidSS CBOR round-trip = %x, %v; want %x", ss.Guid, err, gss.Guid)
+		}
+		// untagged 16-byte bstr
+		ss = GuidSS{}
+		if err := ss.UnmarshalCBOR(cb[2:]); err != nil || ss != gss {
+			t.Fatalf("GuidSS untagged CBOR = %x, %v; want %x", ss.Guid, err, gss.Guid)
+		}
+	}
+
+	var d Guid
+	for _, data := range [][]byte{nil, mp[:17], append(mp, 0), {0xc5, 0x00, 0x10}, append([]byte{0xc4, 0x11}, g[:]...), append([]byte{0xd8, 0x10}, g[:]...)} {
+		if err := d.UnmarshalMsgpack(data); err != ErrInvalidMsgpackGuidEncoding {
+			t.Errorf("UnmarshalMsgpack(%x) error = %v, want ErrInvalidMsgpackGuidEncoding", data, err)
+		}
+	}
+	for _, data := range [][]byte{nil, cb[:18], append(cb, 0), cb[:2], append([]byte{0xd8, 0x26, 0x50}, g[:]...), append([]byte{0x4f}, g[:15]...), append([]byte{0x51}, g[:]...)} {
+		if err := d.UnmarshalCBOR(data); err != ErrInvalidCBORGuidEncoding {
+			t.Errorf("UnmarshalCBOR(%x) error = %v, want ErrInvalidCBORGuidEncoding", data, err)
+		}
+	}
+}
+
+func TestBSON(t *testing.T) {
+	g, _ := ParseCanonical("00112233-4455-6677-8899-aabbccddeeff")
+	header := []byte{0x10, 0x00, 0x00, 0x00}
+
+	typ, data, err := g.MarshalBSONValue()
+	want := append(append(append([]byte(nil), header...), 0x04), g[:]...)
+	if err != nil || typ != 0x05 || !bytes.Equal(data, want) {
+		t.Fatalf("MarshalBSONValue() = 0x%02x, %x, %v; want 0x05, %x", typ, data, err, want)
+	}
+
+	// legacy C# and Java driver byte orders of the same uuid
+	csharp := append(append(append([]byte(nil), header...), 0x03), 0x33, 0x22, 0x11, 0x00, 0x55, 0x44, 0x77, 0x66, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff)
+	java := append(append(append([]byte(nil), header...), 0x03), 0x77, 0x66, 0x55, 0x44, 0x33, 0x22, 0x11, 0x00, 0xff, 0xee, 0xdd, 0xcc, 0xbb, 0xaa, 0x99, 0x88)
+	if _, data, _ := (GuidCSharpLegacy{Guid: g}).MarshalBSONValue(); !bytes.Equal(data, csharp) {
+		t.Errorf("GuidCSharpLegacy.MarshalBSONValue() = %x, want %x", data, csharp)
+	}
+	if _, data, _ := (GuidJavaLegacy{Guid: g}).MarshalBSONValue(); !bytes.Equal(data, java) {
+		t.Errorf("GuidJavaLegacy.MarshalBSONValue() = %x, want %x", data, java)
+	}
+	var gc GuidCSharpLegacy
+	if err := gc.UnmarshalBSONValue(0x05, csharp); err != nil || gc.Guid != g {
+		t.Errorf("GuidCSharpLegacy.UnmarshalBSONValue() = %v, %v; want %v", gc.CanonicalString(), err, g.CanonicalString())
+	}
+	var gj GuidJavaLegacy
+	if err := gj.UnmarshalBSONValue(0x05, java); err != nil || gj.Guid != g {
+		t.Errorf("GuidJavaLegacy.UnmarshalBSONValue() = %v, %v; want %v", gj.CanonicalString(), err, g.CanonicalString())
+	}
+
+	for range 100 {
+		gpg := NewPG()
+		typ, data, _ := gpg.MarshalBSONValue()
+		var pg GuidPG
+		if err := pg.UnmarshalBSONValue(typ, data); err != nil || pg != gpg {
+			t.Fatalf("GuidPG BSON round-trip = %x, %v; want %x", pg.Guid, err, gpg.Guid)
+		}
+	}
+
+	d := New()
+	if err := d.UnmarshalBSONValue(0x0A, nil); err != nil || d != Nil {
+		t.Errorf("UnmarshalBSONValue(null) = %x, %v; want Nil", d, err)
+	}
+	invalid := []struct {
+		typ  byte
+		data []byte
+	}{
+		{0x05, csharp},          // legacy subtype 3
+		{0x04, want},            // BSON array
+		{0x05, want[:20]},       // short
+		{0x05, append(want, 0)}, // long
+		{0x05, append([]byte{0x11}, want[1:]...)}, // length 17
+	}
+	for _, tc := range invalid {
+		if err := d.UnmarshalBSONValue(tc.typ, tc.data); !errors.Is(err, ErrInvalidBSONGuidEncoding) {
+			t.Errorf("UnmarshalBSONValue(0x%02x, %x) error = %v, want ErrInvalidBSONGuidEncoding", tc.typ, tc.data, err)
+		}
+	}
+	if err := gc.UnmarshalBSONValue(0x05, want); !errors.Is(err, ErrInvalidBSONGuidEncoding) {
+		t.Errorf("GuidCSharpLegacy.UnmarshalBSONValue(subtype 4) error = %v, want ErrInvalidBSONGuidEncoding", err)
+	}
+}
+
+func TestAppend(t *testing.T) {
+	for range 100 {
+		g := New()
+		prefix := []byte("id=")
+
+		text, err := g.AppendText(prefix)
+		if err != nil || string(text) != "id="+g.String() {
+			t.Fatalf("AppendText() = %q, %v; want %q", text, err, "id="+g.String())
+		}
+		bin, err := g.AppendBinary(prefix)
+		if err != nil || !bytes.Equal(bin, append([]byte("id="), g[:]...)) {
+			t.Fatalf("AppendBinary() = %x, %v", bin, err)
+		}
+		if c := g.AppendCanonical(prefix); string(c) != "id="+g.CanonicalString() {
+			t.Fatalf("AppendCanonical() = %q, want %q", c, "id="+g.CanonicalString())
+		}
+		if h := g.AppendHex(prefix); string(h) != "id="+strings.ReplaceAll(g.CanonicalString(), "-", "") {
+			t.Fatalf("AppendHex() = %q", h)
+		}
+		if parsed, f, err := ParseAnyBytes(g.AppendHex(nil)); err != nil || parsed != g || f != FormatHex {
+			t.Fatalf("ParseAnyBytes(AppendHex()) = %v, %v, %v; want %v", parsed, f, err, g)
+		}
+		if j, _ := g.MarshalJSON(); string(j) != `"`+g.String()+`"` {
+			t.Fatalf("MarshalJSON() = %s", j)
+		}
+		if mt, _ := g.MarshalText(); string(mt) != g.String() {
+			t.Fatalf("MarshalText() = %s", mt)
+		}
+	}
+
+	g := New()
+	buffer := make([]byte, 0, 128)
+	allocs := testing.AllocsPerRun(100, func() {
+		buffer, _ = g.AppendText(buffer[:0])
+		buffer, _ = g.AppendBinary(buffer)
+		buffer = g.AppendCanonical(buffer)
+		buffer = g.AppendHex(buffer)
+	})
+	if allocs != 0 {
+		t.Errorf("Append methods allocated %v times, want 0", allocs)
+	}
+}
+
+func TestFormatter(t *testing.T) {
+	g, _ := ParseCanonical("00112233-4455-6677-8899-aabbccddeeff")
+	b64 := g.String()
+	canonical := g.CanonicalString()
+	literal := "guid.Guid{0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}"
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%s", b64},
+		{"%v", b64},
+		{"%+s", canonical},
+		{"%+v", canonical},
+		{"%q", `"` + b64 + `"`},
+		{"%+q", `"` + canonical + `"`},
+		{"%#q", "`" + b64 + "`"},
+		{"%x", "00112233445566778899aabbccddeeff"},
+		{"%X", "00112233445566778899AABBCCDDEEFF"},
+		{"%#x", "0x00112233445566778899aabbccddeeff"},
+		{"%#X", "0X00112233445566778899AABBCCDDEEFF"},
+		{"%#v", literal},
+		{"%24s", "  " + b64},
+		{"%-24s|", b64 + "  |"},
+		{"%3s", b64},
+		{"%.5s", b64[:5]},
+		{"%+.8v", canonical[:8]},
+		{"%-26q|", `"` + b64 + `"  |`},
+		{"% x", "00 11 22 33 44 55 66 77 88 99 aa bb cc dd ee ff"},
+		{"%d", "[0 17 34 51 68 85 102 119 136 153 170 187 204 221 238 255]"},
+	}
+	for _, tc := range tests {
+		if got := fmt.Sprintf(tc.format, g); got != tc.want {
+			t.Errorf("Sprintf(%q, g) = %q, want %q", tc.format, got, tc.want)
+		}
+		if got := fmt.Sprintf(tc.format, &g); got != tc.want {
+			t.Errorf("Sprintf(%q, &g) = %q, want %q", tc.format, got, tc.want)
+		}
+	}
+	// %#v of a pointer prints the value literal, without '&' (see Guid.Format)
+	if got := fmt.Sprintf("%#v", &g); got != literal {
+		t.Errorf("Sprintf(%%#v, &g) = %q, want %q", got, literal)
+	}
+
+	// width, precision and flags behave as for the text as a string
+	for _, format := range []string{"%05s", "%030s", "%-030s", "%.0s", "%30.4q", "%+030.40v", "%#30.10q"} {
+		text := b64
+		if strings.Contains(format, "+") {
+			text = canonical
+		}
+		if got, want := fmt.Sprintf(format, g), fmt.Sprintf(format, text); got != want {
+			t.Errorf("Sprintf(%q, g) = %q, want %q", format, got, want)
+		}
+	}
+
+	// types embedding a Guid format like the Guid, with their own Go literal
+	embedding := []struct {
+		value    any
+		typeName string
+	}{
+		{GuidPG{Guid: g}, "GuidPG"},
+		{&GuidSS{Guid: g}, "GuidSS"},
+		{GuidV7{Guid: g}, "GuidV7"},
+		{GuidMySQL{Guid: g}, "GuidMySQL"},
+		{GuidCSharpLegacy{Guid: g}, "GuidCSharpLegacy"},
+		{GuidJavaLegacy{Guid: g}, "GuidJavaLegacy"},
+		{LenientGuid{Guid: g}, "LenientGuid"},
+	}
+	for _, tc := range embedding {
+		if got := fmt.Sprintf("%v %+v %x", tc.value, tc.value, tc.value); got != b64+" "+canonical+" 00112233445566778899aabbccddeeff" {
+			t.Errorf("Sprintf(%s) = %q", tc.typeName, got)
+		}
+		if got, want := fmt.Sprintf("%#v", tc.value), "guid."+tc.typeName+"{Guid:"+literal+"}"; got != want {
+			t.Errorf("Sprintf(%%#v, %s) = %q, want %q", tc.typeName, got, want)
+		}
+	}
+	if got, want := fmt.Sprintf("%#v", NullGuid{Guid: g}), "guid.NullGuid{Guid:"+literal+", Valid:false}"; got != want {
+		t.Errorf("Sprintf(%%#v, NullGuid) = %q, want %q", got, want)
+	}
+	if got := fmt.Sprint(g); got != b64 {
+		t.Errorf("Sprint(g) = %q, want %q", got, b64)
+	}
+}
+
+func TestBase32(t *testing.T) {
+	// ULID spec example
+	g, _ := ParseCanonical("01563e3a-b5d3-d676-4c61-efb99302bd5b")
+	const ulid = "01ARZ3NDEKTSV4RRFFQ69G5FAV"
+	if s := g.Base32String(); s != ulid {
+		t.Fatalf("Base32String() = %q, want %q", s, ulid)
+	}
+	for _, s := range []string{ulid, strings.ToLower(ulid), "01ARZ3NDEK-TSV4RRFF-Q69G5FAV", "-0lARZ3NDEKTSV4RRFFQ69G5FAV-", "OIARZ3NDEKTSV4RRFFQ69G5FAV"} {
+		if parsed, err := ParseBase32(s); err != nil || parsed != g {
+			t.Errorf("ParseBase32(%q) = %v, %v; want %v", s, parsed.CanonicalString(), err, g.CanonicalString())
+		}
+	}
+	if s := Max.Base32String(); s != "7ZZZZZZZZZZZZZZZZZZZZZZZZZ" {
+		t.Errorf("Max.Base32String() = %q", s)
+	}
+	if s := Nil.Base32String(); s != strings.Repeat("0", GuidBase32ByteSize) {
+		t.Errorf("Nil.Base32String() = %q", s)
+	}
+
+	invalid := []string{"", ulid[:25], ulid + "0", "81ARZ3NDEKTSV4RRFFQ69G5FAV", "01ARZ3NDEKTSV4RRFFQ69G5FAU", "01ARZ3NDEKTSV4RRFFQ69G5FA=", "01ARZ3NDEK TSV4RRFFQ69G5FAV"}
+	for _, s := range invalid {
+		if _, err := ParseBase32(s); err != ErrInvalidBase32GuidEncoding {
+			t.Errorf("ParseBase32(%q) error = %v, want ErrInvalidBase32GuidEncoding", s, err)
+		}
+	}
+	if DecodeBase32(make([]byte, 15), []byte(ulid)) {
+		t.Error("DecodeBase32 with a 15-byte dst should fail")
+	}
+	if err := g.EncodeBase32(make([]byte, 25)); err != ErrBufferTooSmallBase32 {
+		t.Errorf("EncodeBase32(25 bytes) error = %v, want ErrBufferTooSmallBase32", err)
+	}
+
+	// GuidPG Base32 strings sort like the bytes
+	prev := NewPG()
+	for range 1000 {
+		next := NewPG()
+		if (bytes.Compare(prev.Guid[:], next.Guid[:]) < 0) != (prev.Base32String() < next.Base32String()) {
+			t.Fatalf("Base32 order of %x and %x does not match byte order", prev.Guid, next.Guid)
+		}
+		if parsed, err := ParseBase32Bytes([]byte(next.Base32String())); err != nil || parsed != next.Guid {
+			t.Fatalf("ParseBase32Bytes round-trip = %x, %v; want %x", parsed, err, next.Guid)
+		}
+		prev = next
+	}
+}
+
+func TestBase58AndBase62(t *testing.T) {
+	g, _ := ParseCanonical("00112233-4455-6677-8899-aabbccddeeff")
+	tests := []struct {
+		g              Guid
+		base58, base62 string
+	}{
+		{g, "11UoWww8DGaVGLtea7zU7p", "007pSo2b9TNg1cedavCe7z"},
+		{Max, "YcVfxkQb6JRzqk5kF2tNLv", "7n42DGM5Tflk9n8mt7Fhc7"},
+		{Nil, "1111111111111111111111", "0000000000000000000000"},
+	}
+	for _, tc := range tests {
+		if s := tc.g.Base58String(); s != tc.base58 {
+			t.Errorf("Base58String(%x) = %q, want %q", tc.g, s, tc.base58)
+		}
+		if s := tc.g.Base62String(); s != tc.base62 {
+			t.Errorf("Base62String(%x) = %q, want %q", tc.g, s, tc.base62)
+		}
+		if parsed, err := ParseBase58(tc.base58); err != nil || parsed != tc.g {
+			t.Errorf("ParseBase58(%q) = %x, %v; want %x", tc.base58, parsed, err, tc.g)
+		}
+		if parsed, err := ParseBase62(tc.base62); err != nil || parsed != tc.g {
+			t.Errorf("ParseBase62(%q) = %x, %v; want %x", tc.base62, parsed, err, tc.g)
+		}
+	}
+
+	// 2^128 (Max + 1), invalid chars, and wrong lengths
+	for _, s := range []string{"YcVfxkQb6JRzqk5kF2tNLw", "zzzzzzzzzzzzzzzzzzzzzz", "0111111111111111111111", "l111111111111111111111", "111111111111111111111", "11111111111111111111111", ""} {
+		if _, err := ParseBase58(s); err != ErrInvalidBase58GuidEncoding {
+			t.Errorf("ParseBase58(%q) error = %v, want ErrInvalidBase58GuidEncoding", s, err)
+		}
+	}
+	for _, s := range []string{"7n42DGM5Tflk9n8mt7Fhc8", "zzzzzzzzzzzzzzzzzzzzzz", "-000000000000000000000", "000000000000000000000", "00000000000000000000000", ""} {
+		if _, err := ParseBase62(s); err != ErrInvalidBase62GuidEncoding {
+			t.Errorf("ParseBase62(%q) error = %v, want ErrInvalidBase62GuidEncoding", s, err)
+		}
+	}
+	if DecodeBase58(make([]byte, 15), []byte(tests[0].base58)) || DecodeBase62(make([]byte, 16), []byte("0")) {
+		t.Error("DecodeBase58/DecodeBase62 should fail on undersized slices")
+	}
+	if err := g.EncodeBase58(make([]byte, 21)); err != ErrBufferTooSmallBase58 {
+		t.Errorf("EncodeBase58(21 bytes) error = %v, want ErrBufferTooSmallBase58", err)
+	}
+	if err := g.EncodeBase62(make([]byte, 21)); err != ErrBufferTooSmallBase62 {
+		t.Errorf("EncodeBase62(21 bytes) error = %v, want ErrBufferTooSmallBase62", err)
+	}
+
+	prev := NewPG()
+	for range 1000 {
+		next := NewPG()
+		if (bytes.Compare(prev.Guid[:], next.Guid[:]) < 0) != (prev.Base58String() < next.Base58String()) ||
+			(bytes.Compare(prev.Guid[:], next.Guid[:]) < 0) != (prev.Base62String() < next.Base62String()) {
+			t.Fatalf("Base58/Base62 order of %x and %x does not match byte order", prev.Guid, next.Guid)
+		}
+		r := New()
+		if parsed, err := ParseBase58Bytes([]byte(r.Base58String())); err != nil || parsed != r {
+			t.Fatalf("Base58 round-trip = %x, %v; want %x", parsed, err, r)
+		}
+		if parsed, err := ParseBase62Bytes([]byte(r.Base62String())); err != nil || parsed != r {
+			t.Fatalf("Base62 round-trip = %x, %v; want %x", parsed, err, r)
+		}
+		prev = next
+	}
+}
+
+func TestSortable(t *testing.T) {
+	if s := Nil.SortableString(); s != strings.Repeat("-", GuidBase64UrlByteSize) {
+		t.Errorf("Nil.SortableString() = %q", s)
+	}
+	if s := Max.SortableString(); s != strings.Repeat("z", GuidBase64UrlByteSize-1)+"k" {
+		t.Errorf("Max.SortableString() = %q", s)
+	}
+
+	prev := NewPG()
+	for range 1000 {
+		next := NewPG()
+		ps, ns := prev.SortableString(), next.SortableString()
+		if (bytes.Compare(prev.Guid[:], next.Guid[:]) < 0) != (ps < ns) {
+			t.Fatalf("sortable order of %x and %x does not match byte order: %q, %q", prev.Guid, next.Guid, ps, ns)
+		}
+		if parsed, err := ParseSortable(ns); err != nil || parsed != next.Guid {
+			t.Fatalf("ParseSortable(%q) = %x, %v; want %x", ns, parsed, err, next.Guid)
+		}
+		if parsed, err := ParseSortableBytes([]byte(ns)); err != nil || parsed != next.Guid {
+			t.Fatalf("ParseSortableBytes(%q) = %x, %v; want %x", ns, parsed, err, next.Guid)
+		}
+		buffer := make([]byte, GuidBase64UrlByteSize)
+		if err := next.EncodeSortable(buffer); err != nil || string(buffer) != ns {
+			t.Fatalf("EncodeSortable() = %q, %v; want %q", buffer, err, ns)
+		}
+		prev = next
+	}
+
+	// random Guids sort the same way as strings and as bytes
+	for range 1000 {
+		a, b := New(), New()
+		if (bytes.Compare(a[:], b[:]) < 0) != (a.SortableString() < b.SortableString()) {
+			t.Fatalf("sortable order of %x and %x does not match byte order", a, b)
+		}
+	}
+
+	for _, s := range []string{"", strings.Repeat("-", 21), strings.Repeat("-", 23), strings.Repeat("-", 21) + "+", strings.Repeat("-", 21) + "="} {
+		if _, err := ParseSortable(s); err != ErrInvalidSortableGuidEncoding {
+			t.Errorf("ParseSortable(%q) error = %v, want ErrInvalidSortableGuidEncoding", s, err)
+		}
+	}
+	if err := Nil.EncodeSortable(make([]byte, 21)); err != ErrBufferTooSmallBase64Url {
+		t.Errorf("EncodeSortable(21 bytes) error = %v, want ErrBufferTooSmallBase64Url", err)
+	}
+}
+
+func TestBase64Variants(t *testing.T) {
+	for range 1000 {
+		g := New()
+		url := base64.RawURLEncoding.EncodeToString(g[:])
+		std := base64.RawStdEncoding.EncodeToString(g[:])
+		urlPadded := base64.URLEncoding.EncodeToString(g[:])
+		stdPadded := base64.StdEncoding.EncodeToString(g[:])
+
+		check := func(name string, parse func(string) (Guid, error), valid, invalid []string) {
+			t.Helper()
+			for _, s := range valid {
+				if parsed, err := parse(s); err != nil || parsed != g {
+					t.Fatalf("%s(%q) = %x, %v; want %x", name, s, parsed, err, g)
+				}
+			}
+			for _, s := range invalid { // wrong length or padding
+				if _, err := parse(s); err != ErrInvalidBase64GuidEncoding {
+					t.Fatalf("%s(%q) error = %v, want ErrInvalidBase64GuidEncoding", name, s, err)
+				}
+			}
+		}
+		check("ParseBase64Std", ParseBase64Std, []string{std}, []string{stdPadded, "", std[:21]})
+		check("ParseBase64StdPadded", ParseBase64StdPadded, []string{stdPadded}, []string{std, std + "=", std + "=A"})
+		check("ParseBase64URLPadded", ParseBase64URLPadded, []string{urlPadded}, []string{url, url + "=", url + "A="})
+		check("ParseBase64Any", ParseBase64Any, []string{url, std, urlPadded, stdPadded}, []string{url + "=", url[:21], "", url + "==="})
+
+		if parsed, err := ParseBase64StdBytes([]byte(std)); err != nil || parsed != g {
+			t.Fatalf("ParseBase64StdBytes(%q) = %x, %v; want %x", std, parsed, err, g)
+		}
+		if parsed, err := ParseBase64AnyBytes([]byte(stdPadded)); err != nil || parsed != g {
+			t.Fatalf("ParseBase64AnyBytes(%q) = %x, %v; want %x", stdPadded, parsed, err, g)
+		}
+		if parsed, err := ParseBase64StdPaddedBytes([]byte(stdPadded)); err != nil || parsed != g {
+			t.Fatalf("ParseBase64StdPaddedBytes(%q) = %x, %v; want %x", stdPadded, parsed, err, g)
+		}
+		if parsed, err := ParseBase64URLPaddedBytes([]byte(urlPadded)); err != nil || parsed != g {
+			t.Fatalf("ParseBase64URLPaddedBytes(%q) = %x, %v; want %x", urlPadded, parsed, err, g)
+		}
+		if _, err := ParseBase64URLPaddedBytes([]byte(url)); err != ErrInvalidBase64GuidEncoding {
+			t.Fatalf("ParseBase64URLPaddedBytes(%q) error = %v, want ErrInvalidBase64GuidEncoding", url, err)
+		}
+		var d Guid
+		if !DecodeBase64Std(d[:], []byte(std)) || d != g || !DecodeBase64Any(d[:], []byte(url)) || d != g {
+			t.Fatalf("DecodeBase64Std/DecodeBase64Any(%q, %q) = %x; want %x", std, url, d, g)
+		}
+	}
+
+	// each alphabet rejects the other alphabet's characters
+	const urlOnly, stdOnly = "AAAAAAAAAAAAAAAAAAAA-A", "AAAAAAAAAAAAAAAAAAAA+A"
+	if _, err := ParseBase64Std(urlOnly); err != ErrInvalidBase64GuidEncoding {
+		t.Errorf("ParseBase64Std(%q) error = %v, want ErrInvalidBase64GuidEncoding", urlOnly, err)
+	}
+	if _, err := ParseBase64URLPadded(stdOnly + "=="); err != ErrInvalidBase64GuidEncoding {
+		t.Errorf("ParseBase64URLPadded(%q) error = %v, want ErrInvalidBase64GuidEncoding", stdOnly+"==", err)
+	}
+	a, errA := ParseBase64Any(urlOnly)
+	b, errB := ParseBase64Any(stdOnly)
+	if errA != nil || errB != nil || a != b {
+		t.Errorf("ParseBase64Any(%q) = %x, %v; ParseBase64Any(%q) = %x, %v; want equal", urlOnly, a, errA, stdOnly, b, errB)
+	}
+	if _, err := Parse(stdOnly); err == nil {
+		t.Error("Parse should stay strict and reject the standard Base64 alphabet")
+	}
+}
+
+func TestCachePoolGetPut(t *testing.T) {
+	// Test internal func to get 100% code coverage
+	t.Helper()
+	defer func() {
+		if r := recover(); r != nil {
+			t.Errorf("unexpected _CachePool panic: %q", r)
+		}
+	}()
+	for range 10 {
+		_CachePool_GetPut()
+	}
+}
+
+func TestSQLScanValue(t *testing.T) {
+	for _, tc := range testcases {
+		want, _ := hex.DecodeString(tc.guidAsHex)
+		canonical := tc.guidAsHex[:8] + "-" + tc.guidAsHex[8:12] + "-" + tc.guidAsHex[12:16] + "-" + tc.guidAsHex[16:20] + "-" + tc.guidAsHex[20:]
+
+		inputs := []any{want, tc.base64Url, []byte(tc.base64Url), canonical, []byte(canonical)}
+		for _, src := range inputs {
+			var g Guid
+			if err := g.Scan(src); err != nil {
+				t.Errorf("Scan(%v) failed: %v", src, err)
+				continue
+			}
+			if !bytes.Equal(g[:], want) {
+				t.Errorf("Scan(%v) = %x, want %x", src, g, want)
+			}
+		}
+
+		var g Guid
+		copy(g[:], want)
+		v, err := g.Value()
+		if err != nil || !bytes.Equal(v.([]byte), want) {
+			t.Errorf("Guid.Value() = %v, %v; want %x", v, err, want)
+		}
+		gpg := GuidPG{Guid: g}
+		v, err = gpg.Value()
+		if err != nil || v.(string) != strings.ToLower(canonical) {
+			t.Errorf("GuidPG.Value() = %v, %v; want %q", v, err, strings.ToLower(canonical))
+		}
+		gss := GuidSS{Guid: g}
+		v, err = gss.Value()
+		if err != nil || v.(string) != gss.SQLServerString() {
+			t.Errorf("GuidSS.Value() = %v, %v; want %q", v, err, gss.SQLServerString())
+		}
+	}
+
+	t.Run("nil", func(t *testing.T) {
+		g := New()
+		if err := g.Scan(nil); err != nil || g != Nil {
+			t.Errorf("Scan(nil) = %x, %v; want Nil", g, err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		inputs := []any{
+			42, 3.14, time.Now(), "", []byte{}, []byte{1, 2, 3}, "short",
+			"!@#$%^&*()_+{}|!@#$%^&",               // 22 chars, invalid Base64Url
+			"00112233-4455-6677-8899-aabbccddeefg", // invalid hex
+			"00112233x4455-6677-8899-aabbccddeeff", // invalid separator
+			"001122334455667788990aabbccddeeff",    // no hyphens
+		}
+		for _, src := range inputs {
+			g := New()
+			g2 := g
+			if err := g.Scan(src); err == nil {
+				t.Errorf("Scan(%v) should fail", src)
+			}
+			if g != g2 {
+				t.Errorf("Scan(%v) modified the Guid on failure", src)
+			}
+		}
+	})
+}
+
+func TestNullGuid(t *testing.T) {
+	type container struct {
+		ID   NullGuid   `json:"id"`
+		PGID NullGuidPG `json:"pgid"`
+		SSID NullGuidSS `json:"ssid"`
+	}
+
+	t.Run("JSON null round-trip", func(t *testing.T) {
+		data, err := json.Marshal(container{})
+		if err != nil {
+			t.Fatalf("json.Marshal failed: %v", err)
+		}
+		if string(data) != `{"id":null,"pgid":null,"ssid":null}` {
+			t.Errorf("json.Marshal = %s", data)
+		}
+		c := container{ID: NullGuid{Guid: New(), Valid: true}}
+		if err := json.Unmarshal(data, &c); err != nil {
+			t.Fatalf("json.Unmarshal failed: %v", err)
+		}
+		if c.ID.Valid || c.ID.Guid != Nil {
+			t.Errorf("json null did not reset NullGuid: %+v", c.ID)
+		}
+	})
+
+	t.Run("JSON value round-trip", func(t *testing.T) {
+		c := container{
+			ID:   NullGuid{Guid: New(), Valid: true},
+			PGID: NullGuidPG{GuidPG: NewPG(), Valid: true},
+			SSID: NullGuidSS{GuidSS: NewSS(), Valid: true},
+		}
+		data, err := json.Marshal(c)
+		if err != nil {
+			t.Fatalf("json.Marshal failed: %v", err)
+		}
+		var c2 container
+		if err := json.Unmarshal(data, &c2); err != nil {
+			t.Fatalf("json.Unmarshal failed: %v", err)
+		}
+		if c != c2 {
+			t.Errorf("JSON round-trip mismatch: %+v vs %+v", c, c2)
+		}
+		if err := json.Unmarshal([]byte(`{"id":"invalid"}`), &c2); err == nil {
+			t.Error("json.Unmarshal should fail on invalid Guid")
+		}
+	})
+
+	t.Run("Scan and Value", func(t *testing.T) {
+		var n NullGuid
+		if v, err := n.Value(); v != nil || err != nil {
+			t.Errorf("invalid NullGuid.Value() = %v, %v; want nil", v, err)
+		}
+		g := New()
+		if err := n.Scan(g[:]); err != nil || !n.Valid || n.Guid != g {
+			t.Errorf("NullGuid.Scan() = %+v, %v", n, err)
+		}
+		if v, err := n.Value(); err != nil || !bytes.Equal(v.([]byte), g[:]) {
+			t.Errorf("NullGuid.Value() = %v, %v", v, err)
+		}
+		if err := n.Scan(nil); err != nil || n.Valid || n.Guid != Nil {
+			t.Errorf("NullGuid.Scan(nil) = %+v, %v", n, err)
+		}
+		if err := n.Scan(42); err == nil {
+			t.Error("NullGuid.Scan(42) should fail")
+		}
+
+		var npg NullGuidPG
+		if v, err := npg.Value(); v != nil || err != nil {
+			t.Errorf("invalid NullGuidPG.Value() = %v, %v; want nil", v, err)
+		}
+		gpg := NewPG()
+		if err := npg.Scan(gpg.Guid[:]); err != nil || !npg.Valid || npg.GuidPG != gpg {
+			t.Errorf("NullGuidPG.Scan() = %+v, %v", npg, err)
+		}
+		if v, err := npg.Value(); err != nil || len(v.(string)) != GuidCanonicalByteSize {
+			t.Errorf("NullGuidPG.Value() = %v, %v", v, err)
+		}
+
+		var nss NullGuidSS
+		if v, err := nss.Value(); v != nil || err != nil {
+			t.Errorf("invalid NullGuidSS.Value() = %v, %v; want nil", v, err)
+		}
+		gss := NewSS()
+		if err := nss.Scan(gss.Guid[:]); err != nil || !nss.Valid || nss.GuidSS != gss {
+			t.Errorf("NullGuidSS.Scan() = %+v, %v", nss, err)
+		}
+		if v, err := nss.Value(); err != nil || len(v.(string)) != GuidCanonicalByteSize {
+			t.Errorf("NullGuidSS.Value() = %v, %v", v, err)
+		}
+	})
+
+	t.Run("Text", func(t *testing.T) {
+		for _, n := range []NullGuid{{}, {Guid: New(), Valid: true}} {
+			txt, err := n.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText failed: %v", err)
+			}
+			if !n.Valid && len(txt) != 0 {
+				t.Errorf("invalid NullGuid.MarshalText() = %q, want empty", txt)
+			}
+			var n2 NullGuid
+			if err := n2.UnmarshalText(txt); err != nil || n2 != n {
+				t.Errorf("Text round-trip mismatch: %+v vs %+v (%v)", n2, n, err)
+			}
+		}
+		for _, n := range []NullGuidPG{{}, {GuidPG: NewPG(), Valid: true}} {
+			txt, _ := n.MarshalText()
+			var n2 NullGuidPG
+			if err := n2.UnmarshalText(txt); err != nil || n2 != n {
+				t.Errorf("Text round-trip mismatch: %+v vs %+v (%v)", n2, n, err)
+			}
+		}
+		for _, n := range []NullGuidSS{{}, {GuidSS: NewSS(), Valid: true}} {
+			txt, _ := n.MarshalText()
+			var n2 NullGuidSS
+			if err := n2.UnmarshalText(txt); err != nil || n2 != n {
+				t.Errorf("Text round-trip mismatch: %+v vs %+v (%v)", n2, n, err)
+			}
+		}
+		var n NullGuid
+		if err := n.UnmarshalText([]byte("short")); err == nil {
+			t.Error("UnmarshalText should fail on invalid text")
+		}
+	})
+}
+
+func TestCanonical(t *testing.T) {
+	for _, tc := range testcases {
+		want, _ := hex.DecodeString(tc.guidAsHex)
+		lower := strings.ToLower(tc.guidAsHex)
+		canonical := lower[:8] + "-" + lower[8:12] + "-" + lower[12:16] + "-" + lower[16:20] + "-" + lower[20:]
+
+		var g Guid
+		copy(g[:], want)
+		if s := g.CanonicalString(); s != canonical {
+			t.Errorf("CanonicalString() = %q, want %q", s, canonical)
+		}
+
+		for _, s := range []string{canonical, strings.ToUpper(canonical)} {
+			g2, err := ParseCanonical(s)
+			if err != nil || g2 != g {
+				t.Errorf("ParseCanonical(%q) = %x, %v; want %x", s, g2, err, g)
+			}
+			g3, err := ParseCanonicalBytes([]byte(s))
+			if err != nil || g3 != g {
+				t.Errorf("ParseCanonicalBytes(%q) = %x, %v; want %x", s, g3, err, g)
+			}
+		}
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		inputs := []string{
+			"", "short",
+			"00112233445566778899aabbccddeeff",       // no hyphens
+			"00112233-4455-6677-8899-aabbccddeeff0",  // too long
+			"00112233-4455-6677-8899-aabbccddeefg",   // invalid hex
+			"0011223-34455-6677-8899-aabbccddeeff",   // misplaced hyphen
+			"{00112233-4455-6677-8899-aabbccddeeff}", // braced
+			"00112233-4455-6677-8899_aabbccddeeff",   // wrong separator
+			"こんにちは世界-4455-6677-8899-aabbccddeeff",    // unicode
+		}
+		for _, s := range inputs {
+			if _, err := ParseCanonical(s); err != ErrInvalidCanonicalGuidEncoding {
+				t.Errorf("ParseCanonical(%q) should fail", s)
+			}
+			if _, err := ParseCanonicalBytes([]byte(s)); err != ErrInvalidCanonicalGuidEncoding {
+				t.Errorf("ParseCanonicalBytes(%q) should fail", s)
+			}
+		}
+		var g Guid
+		if DecodeCanonical(g[:8], []byte("00112233-4455-6677-8899-aabbccddeeff")) {
+			t.Error("DecodeCanonical should fail on undersized dst")
+		}
+	})
+
+	t.Run("EncodeCanonical buffer sizes", func(t *testing.T) {
+		g := New()
+		for bufLen := range GuidCanonicalByteSize {
+			if err := g.EncodeCanonical(make([]byte, bufLen)); err != ErrBufferTooSmallCanonical {
+				t.Error("EncodeCanonical did not return an error on undersized buffer")
+			}
+		}
+		buf := make([]byte, GuidCanonicalByteSize+4)
+		if err := g.EncodeCanonical(buf); err != nil {
+			t.Error("EncodeCanonical returned an error on properly sized buffer")
+		}
+		if string(buf[:GuidCanonicalByteSize]) != g.CanonicalString() {
+			t.Error("EncodeCanonical does not match CanonicalString")
+		}
+	})
+}
+
+func TestParseAny(t *testing.T) {
+	for _, tc := range testcases {
+		want, _ := hex.DecodeString(tc.guidAsHex)
+		var g Guid
+		copy(g[:], want)
+		canonical := g.CanonicalString()
+		std := base64.RawStdEncoding.EncodeToString(g[:])
+
+		inputs := []struct {
+			s      string
+			format Format
+		}{
+			{tc.base64Url, FormatBase64URL},
+			{tc.base64Url + "==", FormatBase64URLPadded},
+			{tc.guidAsHex, FormatHex},
+			{strings.ToUpper(tc.guidAsHex), FormatHex},
+			{canonical, FormatCanonical},
+			{strings.ToUpper(canonical), FormatCanonical},
+			{"{" + canonical + "}", FormatBraced},
+			{"urn:uuid:" + canonical, FormatURN},
+			{"URN:UUID:" + strings.ToUpper(canonical), FormatURN},
+		}
+		if std != tc.base64Url {
+			inputs = append(inputs,
+				struct {
+					s      string
+					format Format
+				}{std, FormatBase64Std},
+				struct {
+					s      string
+					format Format
+				}{std + "==", FormatBase64StdPadded})
+		}
+
+		for _, in := range inputs {
+			g2, f, err := ParseAny(in.s)
+			if err != nil || g2 != g || f != in.format {
+				t.Errorf("ParseAny(%q) = %x, %v, %v; want %x, %v", in.s, g2, f, err, g, in.format)
+			}
+			g3, f, err := ParseAnyBytes([]byte(in.s))
+			if err != nil || g3 != g || f != in.format {
+				t.Errorf("ParseAnyBytes(%q) = %x, %v, %v; want %x, %v", in.s, g3, f, err, g, in.format)
+			}
+		}
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		inputs := []string{
+			"", "short",
+			"AAAAAAAAAAAAAAAAAAA-+A",                           // mixed Base64 alphabets
+			"AAAAAAAAAAAAAAAAAAAAAA=A",                         // bad padding
+			"AAAAAAAAAAAAAAAAAAAAAAAA",                         // 24 chars without padding
+			"00112233445566778899aabbccddeefg",                 // invalid hex
+			"(00112233-4455-6677-8899-aabbccddeeff)",           // wrong braces
+			"{00112233-4455-6677-8899-aabbccddeeff",            // wrong length
+			"urn:guid:00112233-4455-6677-8899-aabbccddeeff",    // wrong prefix
+			"urn:uuid\x1a00112233-4455-6677-8899-aabbccddeeff", // control char in prefix
+		}
+		for _, s := range inputs {
+			if g, f, err := ParseAny(s); err != ErrUnrecognizedGuidFormat || g != Nil || f != FormatUnknown {
+				t.Errorf("ParseAny(%q) = %x, %v, %v; want error", s, g, f, err)
+			}
+		}
+	})
+
+	t.Run("Format.String", func(t *testing.T) {
+		if s := FormatURN.String(); s != "URN" {
+			t.Errorf("FormatURN.String() = %q", s)
+		}
+		if s := Format(200).String(); s != "Format(200)" {
+			t.Errorf("Format(200).String() = %q", s)
+		}
+	})
+}
+
+func TestLenientUnmarshal(t *testing.T) {
+	g := New()
+	canonical := g.CanonicalString()
+	jsonCanonical := []byte(`"` + canonical + `"`)
+
+	var g2 Guid
+	if err := g2.UnmarshalJSON(jsonCanonical); err == nil {
+		t.Error("strict UnmarshalJSON should reject the canonical form")
+	}
+
+	SetLenientUnmarshal(true)
+	defer SetLenientUnmarshal(false)
+
+	if err := g2.UnmarshalText([]byte(canonical)); err != nil || g2 != g {
+		t.Errorf("lenient UnmarshalText(%q) = %x, %v; want %x", canonical, g2, err, g)
+	}
+	g2 = Nil
+	if err := json.Unmarshal(jsonCanonical, &g2); err != nil || g2 != g {
+		t.Errorf("lenient UnmarshalJSON(%s) = %x, %v; want %x", jsonCanonical, g2, err, g)
+	}
+	g2 = Nil
+	if err := g2.UnmarshalJSON([]byte(`"` + g.String() + `"`)); err != nil || g2 != g {
+		t.Errorf("lenient UnmarshalJSON(Base64Url) = %x, %v; want %x", g2, err, g)
+	}
+	if err := g2.UnmarshalText([]byte("not-a-guid")); err == nil {
+		t.Error("lenient UnmarshalText should still reject invalid input")
+	}
+	if err := g2.UnmarshalJSON([]byte(`"not-a-guid"`)); err == nil {
+		t.Error("lenient UnmarshalJSON should still reject invalid input")
+	}
+}
+
+func TestLenientGuid(t *testing.T) {
+	g := New()
+	var v struct {
+		ID     LenientGuid  `json:"id"`
+		Parent *LenientGuid `json:"parent"`
+		Strict Guid         `json:"strict"`
+	}
+	for _, s := range []string{g.String(), g.CanonicalString(), "{" + g.CanonicalString() + "}", "urn:uuid:" + g.CanonicalString()} {
+		v.ID, v.Parent = LenientGuid{}, nil
+		data := `{"id":"` + s + `","parent":"` + s + `","strict":"` + g.String() + `"}`
+		if err := json.Unmarshal([]byte(data), &v); err != nil || v.ID.Guid != g || v.Parent == nil || v.Parent.Guid != g || v.Strict != g {
+			t.Fatalf("json.Unmarshal(%s) = %+v, %v; want %v", data, v, err, g)
+		}
+		var text LenientGuid
+		if err := text.UnmarshalText([]byte(s)); err != nil || text.Guid != g {
+			t.Fatalf("UnmarshalText(%q) = %v, %v; want %v", s, text.Guid, err, g)
+		}
+	}
+
+	// the process-wide setting is unchanged
+	if err := json.Unmarshal([]byte(`{"strict":"`+g.CanonicalString()+`"}`), &v); err == nil {
+		t.Error("strict Guid field should reject the canonical form")
+	}
+	if err := json.Unmarshal([]byte(`{"id":"not-a-guid"}`), &v); err == nil {
+		t.Error("LenientGuid should reject invalid input")
+	}
+	if err := json.Unmarshal([]byte(`{"id":null}`), &v); err != nil || v.ID.Guid != Nil {
+		t.Errorf("json.Unmarshal(null) = %v, %v; want Nil", v.ID.Guid, err)
+	}
+
+	// LenientGuid marshals like Guid
+	data, err := json.Marshal(LenientGuid{Guid: g})
+	if want, _ := g.MarshalJSON(); err != nil || string(data) != string(want) {
+		t.Errorf("json.Marshal = %s, %v; want %s", data, err, want)
+	}
+}
+
+func TestVersionAndVariant(t *testing.T) {
+	for range 1000 {
+		g := NewV4()
+		if g.Version() != 4 || g.Variant() != VariantRFC9562 || !g.IsRFC9562() {
+			t.Fatalf("NewV4() = %x: version %d, variant %v", g, g.Version(), g.Variant())
+		}
+		if s := g.CanonicalString(); s[14] != '4' || !strings.ContainsRune("89ab", rune(s[19])) {
+			t.Fatalf("NewV4() canonical form %q lacks version/variant", s)
+		}
+	}
+
+	testcases := []struct {
+		canonical string
+		version   int
+		variant   Variant
+		isRFC     bool
+	}{
+		{"00000000-0000-0000-0000-000000000000", 0, VariantNCS, false},
+		{"ffffffff-ffff-ffff-ffff-ffffffffffff", 15, VariantFuture, false},
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bf6", 1, VariantRFC9562, true}, // RFC 9562 v1 example
+		{"5df41881-3aed-3515-88a7-2f4a814cf09e", 3, VariantRFC9562, true}, // RFC 9562 v3 example
+		{"919108f7-52d1-4320-9bac-f847db4148a8", 4, VariantRFC9562, true}, // RFC 9562 v4 example
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", 7, VariantRFC9562, true}, // RFC 9562 v7 example
+		{"2489e9ad-2ee2-8e00-8ec9-32d5f69181c0", 8, VariantRFC9562, true}, // RFC 9562 v8 example
+		{"919108f7-52d1-9320-9bac-f847db4148a8", 9, VariantRFC9562, false},
+		{"919108f7-52d1-4320-cbac-f847db4148a8", 4, VariantMicrosoft, false},
+		{"919108f7-52d1-4320-7bac-f847db4148a8", 4, VariantNCS, false},
+	}
+	for _, tc := range testcases {
+		g, _ := ParseCanonical(tc.canonical)
+		if g.Version() != tc.version || g.Variant() != tc.variant || g.IsRFC9562() != tc.isRFC {
+			t.Errorf("%s: got version %d, variant %v, IsRFC9562 %v", tc.canonical, g.Version(), g.Variant(), g.IsRFC9562())
+		}
+	}
+
+	if s := VariantRFC9562.String(); s != "RFC9562" {
+		t.Errorf("VariantRFC9562.String() = %q", s)
+	}
+	if s := Variant(9).String(); s != "Variant(9)" {
+		t.Errorf("Variant(9).String() = %q", s)
+	}
+}
+
+func FuzzParse(f *testing.F) {
+	// Add some valid and invalid seed cases
+	f.Add("AAAAAAAAAAAAAAAAAAAAAA")   // valid (Nil)
+	f.Add("_____________________w")   // valid
+	f.Add("not-a-guid")               // invalid
+	f.Add("")                         // invalid
+	f.Add("AAAAAAAAAAAAAAAAAAAAAA==") // invalid (with padding)
+	f.Add("1234567890123456789012")   // invalid (wrong chars)
+	f.Add("!@#$%^&*()_+{}|")          // invalid (wrong chars, wrong length)
+	f.Add("こんaにちは世ち")                 // unicode with len=22
+
+	f.Fuzz(func(t *testing.T, s string) {
+		g, err := Parse(s)
+		if err != nil {
+			// If Parse fails, that's fine for invalid input
+			return
+		}
+		// If Parse succeeds, the string must be 22 chars and must round-trip
+		if len(s) != GuidBase64UrlByteSize {
+			t.Errorf("Parse succeeded for string of wrong length: %q", s)
+		}
+		// Round-trip: g.String() should equal s (case for valid input)
+		s2 := g.String()
+		g2, err2 := Parse(s2)
+		if err2 != nil {
+			t.Errorf("Parse failed on round-trip string: %q", s2)
+		}
+		if g != g2 {
+			t.Errorf("Round-trip mismatch: got %v, want %v", g2, g)
+		}
+	})
+}
+
+func FuzzParseBytes(f *testing.F) {
+	f.Add([]byte("AAAAAAAAAAAAAAAAAAAAAA"))   // valid
+	f.Add([]byte("not-a-guid"))               // invalid
+	f.Add([]byte(""))                         // invalid
+	f.Add([]byte("AAAAAAAAAAAAAAAAAAAAAA==")) // invalid
+	f.Add([]byte("1234567890123456789012"))   // invalid
+	f.Add([]byte("!@#$%^&*()_+{}|"))          // invalid
+	f.Add([]byte("こんaにちは世ち"))                 // unicode
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		g, err := ParseBytes(b)
+		if err != nil {
+			return
+		}
+		if len(b) != GuidBase64UrlByteSize {
+			t.Errorf("ParseBytes succeeded for slice of wrong length: %q", b)
+		}
+		s := g.String()
+		g2, err2 := Parse(s)
+		if err2 != nil {
+			t.Errorf("Parse failed on round-trip string: %q", s)
+		}
+		if g != g2 {
+			t.Errorf("Round-trip mismatch: got %v, want %v", g2, g)
+		}
+	})
+}
+
+func FuzzParseCanonical(f *testing.F) {
+	f.Add("00000000-0000-0000-0000-000000000000")   // valid (Nil)
+	f.Add("FFFFFFFF-ffff-FFFF-ffff-FFFFFFFFFFFF")   // valid (mixed case)
+	f.Add("00112233-4455-6677-8899-aabbccddeefg")   // invalid hex
+	f.Add("{00112233-4455-6677-8899-aabbccddeeff}") // invalid (braced)
+	f.Add("")                                       // invalid
+
+	f.Fuzz(func(t *testing.T, s string) {
+		g, err := ParseCanonical(s)
+		if err != nil {
+			return
+		}
+		if len(s) != GuidCanonicalByteSize {
+			t.Errorf("ParseCanonical succeeded for string of wrong length: %q", s)
+		}
+		s2 := g.CanonicalString()
+		if s2 != strings.ToLower(s) {
+			t.Errorf("Round-trip mismatch: got %q, want %q", s2, strings.ToLower(s))
+		}
+	})
+}
+
+func FuzzParseBase32(f *testing.F) {
+	f.Add("01ARZ3NDEKTSV4RRFFQ69G5FAV")   // valid (ULID)
+	f.Add("0lARZ3NDEK-tsv4rrff-q69g5fav") // valid (lower case, hyphens, l for 1)
+	f.Add("81ARZ3NDEKTSV4RRFFQ69G5FAV")   // invalid (overflows 128 bits)
+	f.Add("01ARZ3NDEKTSV4RRFFQ69G5FAU")   // invalid char
+	f.Add("")                             // invalid
+
+	f.Fuzz(func(t *testing.T, s string) {
+		g, err := ParseBase32(s)
+		if err != nil {
+			return
+		}
+		normalized := strings.NewReplacer("-", "", "I", "1", "L", "1", "O", "0").Replace(strings.ToUpper(s))
+		if s2 := g.Base32String(); s2 != normalized {
+			t.Errorf("Round-trip mismatch: got %q, want %q", s2, normalized)
+		}
+	})
+}
+
+func ExampleNew() {
+	g := New()      // new random Guid
+	fmt.Println(&g) // calls g.String(), which returns the Base64Url encoded string
+}
+
+func ExampleGuid_String() {
+	// g is a 16-byte Guid represented as a hex string "0123456789abcdef0123456789abcdef"
+	var g Guid = [16]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x10, 0x32, 0x54, 0x76, 0x98, 0xba, 0xdc, 0xfe}
+	fmt.Println(&g) // calls g.String(), which returns the Base64Url encoded string
+	// Output: ASNFZ4mrze8QMlR2mLrc_g
+}
+
+func ExampleGuid_CanonicalString() {
+	var g Guid = [16]byte{0x01, 0x23, 0x45, 0x67, 0x89, 0xab, 0xcd, 0xef, 0x10, 0x32, 0x54, 0x76, 0x98, 0xba, 0xdc, 0xfe}
+	fmt.Println(g.CanonicalString())
+	// Output: 01234567-89ab-cdef-1032-547698badcfe
+}
+
+func ExampleV8Layout() {
+	layout, _ := NewV8Layout(
+		V8Field{Name: "ms", Offset: 0, Width: 48},    // bits 0-47, before the version bits
+		V8Field{Name: "shard", Offset: 52, Width: 8}, // bits 52-59, after the version bits
+	)
+	g, _ := layout.NewBuilder().Set("shard", 42).Set("ms", 1_700_000_000_000).Build()
+	shard, _ := layout.Get(g, "shard")
+	ms, _ := layout.Get(g, "ms")
+	fmt.Println(g.Version(), shard, ms)
+	// Output: 8 42 1700000000000
 }
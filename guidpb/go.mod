@@ -0,0 +1,10 @@
+module github.com/sdrapkin/guid/guidpb
+
+go 1.24
+
+require (
+	github.com/sdrapkin/guid v1.1.1-0.20261017005132-5e8f116da3f7
+	google.golang.org/protobuf v1.36.11
+)
+
+require golang.org/x/sys v0.34.0 // indirect
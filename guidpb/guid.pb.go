@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: sdrapkin/guid/guid.proto
+
+package guidpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Guid is a 16-byte (128-bit) Guid carried as raw bytes.
+type Guid struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value is exactly 16 bytes: the Guid, GuidPG or GuidSS bytes as is.
+	Value         []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Guid) Reset() {
+	*x = Guid{}
+	mi := &file_sdrapkin_guid_guid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Guid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guid) ProtoMessage() {}
+
+func (x *Guid) ProtoReflect() protoreflect.Message {
+	mi := &file_sdrapkin_guid_guid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guid.ProtoReflect.Descriptor instead.
+func (*Guid) Descriptor() ([]byte, []int) {
+	return file_sdrapkin_guid_guid_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Guid) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+var File_sdrapkin_guid_guid_proto protoreflect.FileDescriptor
+
+const file_sdrapkin_guid_guid_proto_rawDesc = "" +
+	"\n" +
+	"\x18sdrapkin/guid/guid.proto\x12\rsdrapkin.guid\"\x1c\n" +
+	"\x04Guid\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\fR\x05valueB!Z\x1fgithub.com/sdrapkin/guid/guidpbb\x06proto3"
+
+var (
+	file_sdrapkin_guid_guid_proto_rawDescOnce sync.Once
+	file_sdrapkin_guid_guid_proto_rawDescData []byte
+)
+
+func file_sdrapkin_guid_guid_proto_rawDescGZIP() []byte {
+	file_sdrapkin_guid_guid_proto_rawDescOnce.Do(func() {
+		file_sdrapkin_guid_guid_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sdrapkin_guid_guid_proto_rawDesc), len(file_sdrapkin_guid_guid_proto_rawDesc)))
+	})
+	return file_sdrapkin_guid_guid_proto_rawDescData
+}
+
+var file_sdrapkin_guid_guid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_sdrapkin_guid_guid_proto_goTypes = []any{
+	(*Guid)(nil), // 0: sdrapkin.guid.Guid
+}
+var file_sdrapkin_guid_guid_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_sdrapkin_guid_guid_proto_init() }
+func file_sdrapkin_guid_guid_proto_init() {
+	if File_sdrapkin_guid_guid_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sdrapkin_guid_guid_proto_rawDesc), len(file_sdrapkin_guid_guid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_sdrapkin_guid_guid_proto_goTypes,
+		DependencyIndexes: file_sdrapkin_guid_guid_proto_depIdxs,
+		MessageInfos:      file_sdrapkin_guid_guid_proto_msgTypes,
+	}.Build()
+	File_sdrapkin_guid_guid_proto = out.File
+	file_sdrapkin_guid_guid_proto_goTypes = nil
+	file_sdrapkin_guid_guid_proto_depIdxs = nil
+}
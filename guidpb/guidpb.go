@@ -0,0 +1,105 @@
+// Package guidpb provides the sdrapkin.guid.Guid Protocol Buffers message (guid.proto),
+// with 16-byte validation and conversions to and from guid.Guid, guid.GuidPG and guid.GuidSS.
+//
+// To use the message in your own .proto files:
+//
+//	import "sdrapkin/guid/guid.proto";
+//
+//	message User {
+//	  sdrapkin.guid.Guid id = 1;
+//	}
+//
+// protojson encodes the message as {"value": "<Base64 bytes>"}. MarshalProtoJSON and UnmarshalProtoJSON
+// encode a single message as the 22-char Base64Url string of guid.Guid.MarshalJSON instead.
+//
+// guid.pb.go is generated by protoc-gen-go from sdrapkin/guid/guid.proto:
+//
+//	protoc --go_out=. --go_opt=module=github.com/sdrapkin/guid/guidpb sdrapkin/guid/guid.proto
+package guidpb
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sdrapkin/guid"
+)
+
+// ErrInvalidLength is returned when a Guid message value is not exactly 16 bytes.
+var ErrInvalidLength = errors.New("guidpb: Guid value must be exactly 16 bytes")
+
+//==============================================
+// Guid Extension Methods
+//==============================================
+
+// Validate returns ErrInvalidLength unless the message value is exactly 16 bytes.
+// A nil message is invalid.
+func (x *Guid) Validate() error {
+	if len(x.GetValue()) != guid.GuidByteSize {
+		return fmt.Errorf("%w, got %d", ErrInvalidLength, len(x.GetValue()))
+	}
+	return nil
+}
+
+//==============================================
+// Standalone Functions
+//==============================================
+
+// ToProto returns a Guid message holding the 16 bytes of g.
+func ToProto(g guid.Guid) *Guid {
+	return &Guid{Value: append([]byte(nil), g[:]...)}
+}
+
+// ToProtoPG returns a Guid message holding the 16 bytes of gpg.
+func ToProtoPG(gpg guid.GuidPG) *Guid {
+	return ToProto(gpg.Guid)
+}
+
+// ToProtoSS returns a Guid message holding the 16 bytes of gss.
+func ToProtoSS(gss guid.GuidSS) *Guid {
+	return ToProto(gss.Guid)
+}
+
+// MarshalProtoJSON returns the JSON encoding of the message as a 22-char Base64Url string,
+// or ErrInvalidLength if the value is not exactly 16 bytes.
+func MarshalProtoJSON(x *Guid) ([]byte, error) {
+	g, err := FromProto(x)
+	if err != nil {
+		return nil, err
+	}
+	return g.MarshalJSON()
+}
+
+// UnmarshalProtoJSON sets the message value from a JSON 22-char Base64Url string, as produced by MarshalProtoJSON.
+func UnmarshalProtoJSON(data []byte, x *Guid) error {
+	n := len(data)
+	if n < 2 || data[0] != '"' || data[n-1] != '"' {
+		return fmt.Errorf("guidpb: cannot unmarshal %q into a Guid: JSON string expected", data)
+	}
+	g, err := guid.ParseBytes(data[1 : n-1])
+	if err != nil {
+		return err
+	}
+	x.Value = append(x.Value[:0], g[:]...)
+	return nil
+}
+
+// FromProto returns the Guid held by the message, or ErrInvalidLength if the value is not exactly 16 bytes.
+func FromProto(x *Guid) (g guid.Guid, err error) {
+	if err = x.Validate(); err != nil {
+		return
+	}
+	copy(g[:], x.Value)
+	return
+}
+
+// FromProtoPG returns the GuidPG held by the message, or ErrInvalidLength if the value is not exactly 16 bytes.
+func FromProtoPG(x *Guid) (guid.GuidPG, error) {
+	g, err := FromProto(x)
+	return guid.GuidPG{Guid: g}, err
+}
+
+// FromProtoSS returns the GuidSS held by the message, or ErrInvalidLength if the value is not exactly 16 bytes.
+func FromProtoSS(x *Guid) (guid.GuidSS, error) {
+	g, err := FromProto(x)
+	return guid.GuidSS{Guid: g}, err
+}
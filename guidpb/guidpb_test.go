@@ -0,0 +1,95 @@
+package guidpb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sdrapkin/guid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestToProtoFromProto(t *testing.T) {
+	g := guid.New()
+
+	x := ToProto(g)
+	if err := x.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	want := g
+	g[0] ^= 0xFF // the message must not alias g
+	got, err := FromProto(x)
+	if err != nil || got != want {
+		t.Fatalf("FromProto = %v, %v; want %v", got, err, want)
+	}
+	g = want
+
+	gpg := guid.NewPG()
+	if got, err := FromProtoPG(ToProtoPG(gpg)); err != nil || got != gpg {
+		t.Fatalf("FromProtoPG = %v, %v; want %v", got, err, gpg)
+	}
+	gss := guid.NewSS()
+	if got, err := FromProtoSS(ToProtoSS(gss)); err != nil || got != gss {
+		t.Fatalf("FromProtoSS = %v, %v; want %v", got, err, gss)
+	}
+
+	b, err := proto.Marshal(x)
+	if err != nil {
+		t.Fatalf("proto.Marshal failed: %v", err)
+	}
+	var y Guid
+	if err := proto.Unmarshal(b, &y); err != nil {
+		t.Fatalf("proto.Unmarshal failed: %v", err)
+	}
+	if got, err := FromProto(&y); err != nil || got != g {
+		t.Fatalf("wire round-trip = %v, %v; want %v", got, err, g)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, x := range []*Guid{nil, {}, {Value: make([]byte, 15)}, {Value: make([]byte, 17)}} {
+		if err := x.Validate(); !errors.Is(err, ErrInvalidLength) {
+			t.Fatalf("Validate(%v) = %v, want ErrInvalidLength", x, err)
+		}
+		if _, err := FromProto(x); !errors.Is(err, ErrInvalidLength) {
+			t.Fatalf("FromProto(%v) = %v, want ErrInvalidLength", x, err)
+		}
+	}
+}
+
+func TestProtoJSON(t *testing.T) {
+	g := guid.New()
+	x := ToProto(g)
+
+	data, err := MarshalProtoJSON(x)
+	if err != nil {
+		t.Fatalf("MarshalProtoJSON failed: %v", err)
+	}
+	if want := `"` + g.String() + `"`; string(data) != want {
+		t.Fatalf("MarshalProtoJSON = %s, want %s", data, want)
+	}
+	var y Guid
+	if err := UnmarshalProtoJSON(data, &y); err != nil {
+		t.Fatalf("UnmarshalProtoJSON failed: %v", err)
+	}
+	if got, _ := FromProto(&y); got != g {
+		t.Fatalf("UnmarshalProtoJSON round-trip = %v, want %v", got, g)
+	}
+	if _, err := MarshalProtoJSON(&Guid{Value: []byte{1}}); !errors.Is(err, ErrInvalidLength) {
+		t.Fatalf("MarshalProtoJSON of an invalid message = %v, want ErrInvalidLength", err)
+	}
+	for _, bad := range []string{``, `null`, g.String(), `"` + g.CanonicalString() + `"`, `{"value":"` + g.String() + `"}`} {
+		if err := UnmarshalProtoJSON([]byte(bad), &y); err == nil {
+			t.Fatalf("UnmarshalProtoJSON(%s) should fail", bad)
+		}
+	}
+
+	// protojson accepts the Base64Url form of the bytes.
+	var z Guid
+	if err := protojson.Unmarshal([]byte(`{"value":"`+g.String()+`"}`), &z); err != nil {
+		t.Fatalf("protojson.Unmarshal failed: %v", err)
+	}
+	if got, _ := FromProto(&z); got != g {
+		t.Fatalf("protojson round-trip = %v, want %v", got, g)
+	}
+}
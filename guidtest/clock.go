@@ -0,0 +1,41 @@
+// Package guidtest provides helpers for testing code that generates sequential Guids.
+package guidtest
+
+import (
+	"sync"
+	"time"
+)
+
+// FakeClock is a manually controlled clock that satisfies the guid.Clock interface.
+// Use it with guid.NewSequentialGenerator for deterministic tests and simulated-time replays.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFakeClock returns a FakeClock set to start.
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now returns the current fake time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Set sets the fake time to t. t may be earlier than the current fake time.
+func (c *FakeClock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// Advance moves the fake time forward by d (or backwards, if d is negative).
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
@@ -0,0 +1,66 @@
+package guid
+
+import "unsafe"
+
+//==============================================
+// Guid Extension Methods
+//==============================================
+
+// ToMicrosoftBytes returns the Guid in the mixed-endian byte order used by .NET System.Guid.ToByteArray()
+// and SQL Server uniqueidentifier storage: the first three groups (4, 2 and 2 bytes) are little-endian.
+// new System.Guid(g.ToMicrosoftBytes()).ToString() equals g.CanonicalString().
+func (guid Guid) ToMicrosoftBytes() [GuidByteSize]byte {
+	swapMicrosoftBytes(&guid)
+	return guid
+}
+
+//==============================================
+// GuidSS Extension Methods
+//==============================================
+
+// SQLServerString returns the canonical hyphenated text that SQL Server (and .NET System.Guid) displays for the GuidSS.
+// GuidSS bytes are SQL Server uniqueidentifier storage bytes, which SQL Server displays with the first three groups byte-swapped.
+func (g *GuidSS) SQLServerString() string {
+	swapped := g.Guid.ToMicrosoftBytes()
+	return (*Guid)(&swapped).CanonicalString()
+}
+
+//==============================================
+// Standalone Functions
+//==============================================
+
+// FromMicrosoftBytes returns a Guid from the first 16 bytes of src in the mixed-endian byte order
+// used by .NET System.Guid.ToByteArray() and SQL Server uniqueidentifier storage.
+// It is the inverse of Guid.ToMicrosoftBytes.
+func FromMicrosoftBytes(src []byte) (Guid, error) {
+	g, err := FromBytes(src)
+	if err != nil {
+		return Guid{}, err
+	}
+	swapMicrosoftBytes(&g)
+	return g, nil
+}
+
+// ParseSQLServerString parses the canonical hyphenated text that SQL Server displays for a uniqueidentifier
+// into a GuidSS with the same storage bytes (and therefore the same SQL Server sort order).
+// It is the inverse of GuidSS.SQLServerString.
+func ParseSQLServerString(s string) (GuidSS, error) {
+	// Zero-copy conversion of a string to a byte slice
+	return parseSQLServerString(unsafe.Slice(unsafe.StringData(s), len(s)))
+}
+
+func parseSQLServerString(src []byte) (gss GuidSS, err error) {
+	if gss.Guid, err = ParseCanonicalBytes(src); err != nil {
+		return GuidSS{}, err
+	}
+	swapMicrosoftBytes(&gss.Guid)
+	return gss, nil
+}
+
+// swapMicrosoftBytes converts between RFC 9562 (big-endian) and Microsoft mixed-endian byte order, in place.
+// The conversion is its own inverse.
+func swapMicrosoftBytes(g *Guid) {
+	g[0], g[1], g[2], g[3] = g[3], g[2], g[1], g[0]
+	g[4], g[5] = g[5], g[4]
+	g[6], g[7] = g[7], g[6]
+}
@@ -0,0 +1,35 @@
+package guid
+
+import "errors"
+
+const (
+	GuidMsgpackByteSize = 2 + GuidByteSize // MessagePack bin8 encoding of a Guid is 18 bytes: [0xc4][0x10][16 bytes]
+	msgpackBin8         = 0xc4             // MessagePack bin8 format byte (8-bit length follows)
+)
+
+// ErrInvalidMsgpackGuidEncoding is returned when MessagePack data is not a 16-byte bin8 Guid.
+var ErrInvalidMsgpackGuidEncoding = errors.New("invalid MessagePack Guid encoding (not a 16-byte bin8)")
+
+//==============================================
+// Guid Extension Methods
+//==============================================
+
+// MarshalMsgpack implements the msgpack.Marshaler interface (e.g. github.com/vmihailenco/msgpack/v5).
+// The Guid is encoded as a compact 16-byte MessagePack bin8: [0xc4][0x10][16 bytes].
+// GuidPG and GuidSS are encoded the same way (their bytes as is).
+func (guid Guid) MarshalMsgpack() ([]byte, error) {
+	data := make([]byte, GuidMsgpackByteSize)
+	data[0], data[1] = msgpackBin8, GuidByteSize
+	copy(data[2:], guid[:])
+	return data, nil
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface (e.g. github.com/vmihailenco/msgpack/v5).
+// It accepts only a 16-byte MessagePack bin8, as produced by MarshalMsgpack.
+func (guid *Guid) UnmarshalMsgpack(data []byte) error {
+	if len(data) != GuidMsgpackByteSize || data[0] != msgpackBin8 || data[1] != GuidByteSize {
+		return ErrInvalidMsgpackGuidEncoding
+	}
+	copy(guid[:], data[2:])
+	return nil
+}
@@ -0,0 +1,118 @@
+package guid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math/bits"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/cpu"
+)
+
+// GuidMySQL is a 16-byte (128-bit) MySQL sortable Guid formed as
+// [4-bit version 1][60-bit timestamp in 100ns units since 1582-10-15][2-bit variant][62 random bits].
+// GuidMySQL bytes are exactly what MySQL 8 UUID_TO_BIN(uuid, 1) stores for an RFC 9562 version 1 uuid,
+// so they sort by creation time as memcmp-ordered BINARY(16) clustered index keys (InnoDB),
+// and BIN_TO_UUID(id, 1) displays them as a version 1 uuid.
+type GuidMySQL struct {
+	Guid // embedded
+}
+
+const (
+	gregorianToUnix100ns = 0x01B21DD213814000 // 100ns intervals between 1582-10-15 and 1970-01-01
+	mysqlTimestampMask   = 1<<60 - 1          // 60-bit version 1 timestamp
+)
+
+var (
+	_ sql.Scanner   = &GuidMySQL{}
+	_ driver.Valuer = GuidMySQL{}
+)
+
+//==============================================
+// GuidMySQL Extension Methods
+//==============================================
+
+// Timestamp extracts the timestamp from the MySQL Guid.
+// The timestamp is stored in the first 8 bytes (after the version nibble) in 100ns units since 1582-10-15.
+// Returns the time.Time representation of when the Guid was created.
+func (g *GuidMySQL) Timestamp() time.Time {
+	head := *(*uint64)(unsafe.Pointer(&g.Guid[0])) // Extract timestamp from first 8 bytes
+	if !cpu.IsBigEndian {
+		head = bits.ReverseBytes64(head)
+	}
+	return time.Unix(0, (int64(head&mysqlTimestampMask)-gregorianToUnix100ns)*100).UTC()
+}
+
+// UUIDv1 returns the RFC 9562 version 1 Guid that MySQL BIN_TO_UUID(id, 1) displays for the GuidMySQL
+// (the time_low and time_high groups swapped back). Its CanonicalString() is the MySQL uuid text.
+func (g *GuidMySQL) UUIDv1() (v1 Guid) {
+	copy(v1[0:4], g.Guid[4:8])
+	copy(v1[4:6], g.Guid[2:4])
+	copy(v1[6:8], g.Guid[0:2])
+	copy(v1[8:], g.Guid[8:])
+	return
+}
+
+// Scan implements the sql.Scanner interface.
+// 16 raw bytes are the BINARY(16) storage bytes, and are copied as is.
+// 36-char canonical text is a version 1 uuid (e.g. BIN_TO_UUID(id, 1)), and is swapped like UUID_TO_BIN(uuid, 1).
+// nil and 22-char Base64Url text are handled like Guid.Scan.
+func (g *GuidMySQL) Scan(src any) error {
+	var text []byte
+	switch src := src.(type) {
+	case []byte:
+		text = src
+	case string:
+		// Zero-copy conversion of a string to a byte slice
+		text = unsafe.Slice(unsafe.StringData(src), len(src))
+	}
+	if len(text) != GuidCanonicalByteSize {
+		return g.Guid.Scan(src)
+	}
+
+	v1, err := ParseCanonicalBytes(text)
+	if err != nil {
+		return fmt.Errorf("guid: cannot scan %q into a GuidMySQL", text)
+	}
+	*g = fromUUIDv1(v1)
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the 16 raw bytes of the GuidMySQL, for BINARY(16) columns.
+func (g GuidMySQL) Value() (driver.Value, error) {
+	return g.Guid.Value()
+}
+
+//==============================================
+// Standalone Functions
+//==============================================
+
+// NewMySQL generates a new MySQL sortable Guid as [version 1 time.Now() timestamp, time_high first][random clock sequence and node].
+// The random node has the multicast bit set, as RFC 9562 requires for version 1 uuids without a MAC address.
+func NewMySQL() GuidMySQL {
+	return newMySQL(time.Now().UnixNano())
+}
+
+func newMySQL(ts int64) (gm GuidMySQL) {
+	gm.Guid = New()
+	head := uint64(1)<<60 | uint64(ts/100+gregorianToUnix100ns)&mysqlTimestampMask
+	if !cpu.IsBigEndian {
+		head = bits.ReverseBytes64(head)
+	}
+	*(*uint64)(unsafe.Pointer(&gm.Guid[0])) = head
+	gm.Guid[8] = (gm.Guid[8] & 0x3F) | 0x80 // variant
+	gm.Guid[10] |= 0x01                     // multicast bit of the random node
+	return
+}
+
+// fromUUIDv1 applies the UUID_TO_BIN(uuid, 1) swap to a version 1 uuid.
+func fromUUIDv1(v1 Guid) (gm GuidMySQL) {
+	copy(gm.Guid[0:2], v1[6:8])
+	copy(gm.Guid[2:4], v1[4:6])
+	copy(gm.Guid[4:8], v1[0:4])
+	copy(gm.Guid[8:], v1[8:])
+	return
+}
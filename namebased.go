@@ -0,0 +1,58 @@
+package guid
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"hash"
+)
+
+// Predefined RFC 9562 namespaces for name-based (version 3 and 5) Guids.
+var (
+	NamespaceDNS  = Guid{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8} // 6ba7b810-9dad-11d1-80b4-00c04fd430c8
+	NamespaceURL  = Guid{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8} // 6ba7b811-9dad-11d1-80b4-00c04fd430c8
+	NamespaceOID  = Guid{0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8} // 6ba7b812-9dad-11d1-80b4-00c04fd430c8
+	NamespaceX500 = Guid{0x6b, 0xa7, 0xb8, 0x14, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8} // 6ba7b814-9dad-11d1-80b4-00c04fd430c8
+)
+
+// maxStackName is the longest name hashed from a stack buffer (without allocations).
+const maxStackName = 256 - GuidByteSize
+
+// NewV5 generates a deterministic RFC 9562 version 5 Guid from the SHA-1 hash of namespace and name.
+// The same namespace and name always produce the same Guid. Names up to 240 bytes do not allocate.
+func NewV5(namespace Guid, name []byte) Guid {
+	if len(name) <= maxStackName {
+		var buffer [GuidByteSize + maxStackName]byte
+		copy(buffer[:], namespace[:])
+		n := copy(buffer[GuidByteSize:], name)
+		sum := sha1.Sum(buffer[:GuidByteSize+n])
+		return newNameBased(sum[:], 5)
+	}
+	return newNameBasedHash(sha1.New(), namespace, name, 5)
+}
+
+// NewV3 generates a deterministic RFC 9562 version 3 Guid from the MD5 hash of namespace and name.
+// RFC 9562 recommends NewV5 over NewV3 for new applications. Names up to 240 bytes do not allocate.
+func NewV3(namespace Guid, name []byte) Guid {
+	if len(name) <= maxStackName {
+		var buffer [GuidByteSize + maxStackName]byte
+		copy(buffer[:], namespace[:])
+		n := copy(buffer[GuidByteSize:], name)
+		sum := md5.Sum(buffer[:GuidByteSize+n])
+		return newNameBased(sum[:], 3)
+	}
+	return newNameBasedHash(md5.New(), namespace, name, 3)
+}
+
+func newNameBasedHash(h hash.Hash, namespace Guid, name []byte, version byte) Guid {
+	h.Write(namespace[:])
+	h.Write(name)
+	var sum [sha1.Size]byte
+	return newNameBased(h.Sum(sum[:0]), version)
+}
+
+// private - takes the first 16 bytes of the hash sum, and stamps the version and variant bits.
+func newNameBased(sum []byte, version byte) (g Guid) {
+	copy(g[:], sum)
+	g.setVersionAndVariant(version)
+	return
+}
@@ -0,0 +1,214 @@
+package guid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+)
+
+//==============================================
+// Compile-time interface assertions
+//==============================================
+
+var (
+	_ sql.Scanner              = &NullGuid{}
+	_ driver.Valuer            = NullGuid{}
+	_ json.Marshaler           = NullGuid{}
+	_ json.Unmarshaler         = &NullGuid{}
+	_ encoding.TextMarshaler   = NullGuid{}
+	_ encoding.TextUnmarshaler = &NullGuid{}
+	_ sql.Scanner              = &NullGuidPG{}
+	_ driver.Valuer            = NullGuidPG{}
+	_ sql.Scanner              = &NullGuidSS{}
+	_ driver.Valuer            = NullGuidSS{}
+)
+
+//==============================================
+// Types
+//==============================================
+
+// NullGuid represents a Guid that may be null. It mirrors sql.NullString.
+// The zero value is a valid null: it scans and stores SQL NULL, marshals to JSON null and to empty text.
+type NullGuid struct {
+	Guid  Guid
+	Valid bool // Valid is true if Guid is not NULL
+}
+
+// NullGuidPG represents a GuidPG that may be null. It mirrors sql.NullString.
+type NullGuidPG struct {
+	GuidPG GuidPG
+	Valid  bool // Valid is true if GuidPG is not NULL
+}
+
+// NullGuidSS represents a GuidSS that may be null. It mirrors sql.NullString.
+type NullGuidSS struct {
+	GuidSS GuidSS
+	Valid  bool // Valid is true if GuidSS is not NULL
+}
+
+//==============================================
+// NullGuid Extension Methods
+//==============================================
+
+// Scan implements the sql.Scanner interface. A nil src sets Valid to false.
+func (n *NullGuid) Scan(src any) error {
+	return scanNull(&n.Guid, &n.Guid, &n.Valid, src)
+}
+
+// Value implements the driver.Valuer interface. It returns nil if Valid is false.
+func (n NullGuid) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Guid.Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface. It returns null if Valid is false.
+func (n NullGuid) MarshalJSON() ([]byte, error) {
+	return marshalNullJSON(&n.Guid, n.Valid)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. A JSON null sets Valid to false.
+func (n *NullGuid) UnmarshalJSON(data []byte) error {
+	return unmarshalNullJSON(&n.Guid, &n.Valid, data)
+}
+
+// MarshalText implements encoding.TextMarshaler. It returns empty text if Valid is false.
+func (n NullGuid) MarshalText() ([]byte, error) {
+	return marshalNullText(&n.Guid, n.Valid)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text sets Valid to false.
+func (n *NullGuid) UnmarshalText(data []byte) error {
+	return unmarshalNullText(&n.Guid, &n.Valid, data)
+}
+
+//==============================================
+// NullGuidPG Extension Methods
+//==============================================
+
+// Scan implements the sql.Scanner interface. A nil src sets Valid to false.
+func (n *NullGuidPG) Scan(src any) error {
+	return scanNull(&n.GuidPG, &n.GuidPG.Guid, &n.Valid, src)
+}
+
+// Value implements the driver.Valuer interface. It returns nil if Valid is false.
+func (n NullGuidPG) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.GuidPG.Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface. It returns null if Valid is false.
+func (n NullGuidPG) MarshalJSON() ([]byte, error) {
+	return marshalNullJSON(&n.GuidPG.Guid, n.Valid)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. A JSON null sets Valid to false.
+func (n *NullGuidPG) UnmarshalJSON(data []byte) error {
+	return unmarshalNullJSON(&n.GuidPG.Guid, &n.Valid, data)
+}
+
+// MarshalText implements encoding.TextMarshaler. It returns empty text if Valid is false.
+func (n NullGuidPG) MarshalText() ([]byte, error) {
+	return marshalNullText(&n.GuidPG.Guid, n.Valid)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text sets Valid to false.
+func (n *NullGuidPG) UnmarshalText(data []byte) error {
+	return unmarshalNullText(&n.GuidPG.Guid, &n.Valid, data)
+}
+
+//==============================================
+// NullGuidSS Extension Methods
+//==============================================
+
+// Scan implements the sql.Scanner interface. A nil src sets Valid to false.
+func (n *NullGuidSS) Scan(src any) error {
+	return scanNull(&n.GuidSS, &n.GuidSS.Guid, &n.Valid, src)
+}
+
+// Value implements the driver.Valuer interface. It returns nil if Valid is false.
+func (n NullGuidSS) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.GuidSS.Value()
+}
+
+// MarshalJSON implements the json.Marshaler interface. It returns null if Valid is false.
+func (n NullGuidSS) MarshalJSON() ([]byte, error) {
+	return marshalNullJSON(&n.GuidSS.Guid, n.Valid)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. A JSON null sets Valid to false.
+func (n *NullGuidSS) UnmarshalJSON(data []byte) error {
+	return unmarshalNullJSON(&n.GuidSS.Guid, &n.Valid, data)
+}
+
+// MarshalText implements encoding.TextMarshaler. It returns empty text if Valid is false.
+func (n NullGuidSS) MarshalText() ([]byte, error) {
+	return marshalNullText(&n.GuidSS.Guid, n.Valid)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text sets Valid to false.
+func (n *NullGuidSS) UnmarshalText(data []byte) error {
+	return unmarshalNullText(&n.GuidSS.Guid, &n.Valid, data)
+}
+
+//==============================================
+// Shared null helpers
+//==============================================
+
+// scanNull scans src into dst (which stores into g), or sets g to Nil and valid to false if src is nil.
+func scanNull(dst sql.Scanner, g *Guid, valid *bool, src any) error {
+	if src == nil {
+		*g, *valid = Guid{}, false
+		return nil
+	}
+	if err := dst.Scan(src); err != nil {
+		return err
+	}
+	*valid = true
+	return nil
+}
+
+func marshalNullJSON(g *Guid, valid bool) ([]byte, error) {
+	if !valid {
+		return []byte("null"), nil
+	}
+	return g.MarshalJSON()
+}
+
+func unmarshalNullJSON(g *Guid, valid *bool, data []byte) error {
+	if string(data) == "null" {
+		*g, *valid = Guid{}, false
+		return nil
+	}
+	if err := g.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*valid = true
+	return nil
+}
+
+func marshalNullText(g *Guid, valid bool) ([]byte, error) {
+	if !valid {
+		return []byte{}, nil
+	}
+	return g.MarshalText()
+}
+
+func unmarshalNullText(g *Guid, valid *bool, data []byte) error {
+	if len(data) == 0 {
+		*g, *valid = Guid{}, false
+		return nil
+	}
+	if err := g.UnmarshalText(data); err != nil {
+		return err
+	}
+	*valid = true
+	return nil
+}
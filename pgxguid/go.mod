@@ -0,0 +1,10 @@
+module github.com/sdrapkin/guid/pgxguid
+
+go 1.24
+
+require (
+	github.com/jackc/pgx/v5 v5.7.5
+	github.com/sdrapkin/guid v1.1.1-0.20261017005132-5e8f116da3f7
+)
+
+require golang.org/x/sys v0.34.0 // indirect
@@ -0,0 +1,258 @@
+// Package pgxguid registers github.com/sdrapkin/guid types with jackc/pgx/v5, so that
+// guid.Guid, guid.GuidPG, guid.GuidSS, guid.GuidV7 and the guid.NullGuid types map directly
+// to the Postgres uuid type (and uuid[] arrays) in both text and binary formats, including COPY FROM.
+// Values are encoded and scanned as 16 bytes, without the database/sql string round-trip.
+// GuidSS and NullGuidSS use the byte order of guid.GuidSS.Value (see guid.GuidSS.SQLServerString),
+// so that the stored uuid is the same whether or not Register was called.
+//
+// Register the types on every connection:
+//
+//	config.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		pgxguid.Register(conn.TypeMap())
+//		return nil
+//	}
+package pgxguid
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/sdrapkin/guid"
+)
+
+var errScanNull = errors.New("pgxguid: cannot scan NULL into a non-nullable guid")
+
+//==============================================
+// Compile-time interface assertions
+//==============================================
+
+var (
+	_ pgtype.UUIDScanner = &UUID{}
+	_ pgtype.UUIDValuer  = UUID{}
+	_ pgtype.UUIDScanner = &NullUUID{}
+	_ pgtype.UUIDValuer  = NullUUID{}
+	_ pgtype.UUIDScanner = uuidTarget{}
+)
+
+//==============================================
+// Types
+//==============================================
+
+// UUID is a guid.Guid that implements pgtype.UUIDScanner and pgtype.UUIDValuer.
+type UUID guid.Guid
+
+// NullUUID is a guid.NullGuid that implements pgtype.UUIDScanner and pgtype.UUIDValuer.
+type NullUUID guid.NullGuid
+
+// uuidTarget scans into the fields of guid.GuidSS, guid.NullGuidPG and guid.NullGuidSS.
+// A nil valid rejects NULL; microsoft swaps the bytes back from the guid.GuidSS.Value byte order.
+type uuidTarget struct {
+	guid      *guid.Guid
+	valid     *bool
+	microsoft bool
+}
+
+// UUIDCodec is pgtype.UUIDCodec that decodes uuid values to guid.Guid (e.g. for Rows.Values).
+type UUIDCodec struct {
+	pgtype.UUIDCodec
+}
+
+//==============================================
+// UUID and NullUUID Extension Methods
+//==============================================
+
+// ScanUUID implements the pgtype.UUIDScanner interface. NULL cannot be scanned into a UUID.
+func (u *UUID) ScanUUID(v pgtype.UUID) error {
+	if !v.Valid {
+		return errScanNull
+	}
+	*u = v.Bytes
+	return nil
+}
+
+// UUIDValue implements the pgtype.UUIDValuer interface.
+func (u UUID) UUIDValue() (pgtype.UUID, error) {
+	return pgtype.UUID{Bytes: u, Valid: true}, nil
+}
+
+// ScanUUID implements the pgtype.UUIDScanner interface. NULL scans to the zero NullUUID.
+func (u *NullUUID) ScanUUID(v pgtype.UUID) error {
+	*u = NullUUID{Guid: v.Bytes, Valid: v.Valid}
+	return nil
+}
+
+// UUIDValue implements the pgtype.UUIDValuer interface. An invalid NullUUID is NULL.
+func (u NullUUID) UUIDValue() (pgtype.UUID, error) {
+	return pgtype.UUID{Bytes: u.Guid, Valid: u.Valid}, nil
+}
+
+func (t uuidTarget) ScanUUID(v pgtype.UUID) error {
+	if !v.Valid && t.valid == nil {
+		return errScanNull
+	}
+	g := guid.Guid(v.Bytes)
+	if t.microsoft {
+		g = g.ToMicrosoftBytes()
+	}
+	*t.guid = g
+	if t.valid != nil {
+		*t.valid = v.Valid
+	}
+	return nil
+}
+
+// PlanScan scans guid targets through pgtype.UUIDScanner. pgx would otherwise prefer their sql.Scanner
+// implementation, which round-trips through text.
+func (c UUIDCodec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if next := wrapTarget(target); next != nil {
+		if plan := c.UUIDCodec.PlanScan(m, oid, format, next); plan != nil {
+			return &wrapScanPlan{next: plan}
+		}
+	}
+	return c.UUIDCodec.PlanScan(m, oid, format, target)
+}
+
+// DecodeValue decodes a uuid into a guid.Guid, or nil for NULL.
+func (UUIDCodec) DecodeValue(tm *pgtype.Map, oid uint32, format int16, src []byte) (any, error) {
+	if src == nil {
+		return nil, nil
+	}
+	var g guid.Guid
+	plan := tm.PlanScan(oid, format, &g)
+	if plan == nil {
+		return nil, fmt.Errorf("pgxguid: no plan to scan uuid into guid.Guid")
+	}
+	if err := plan.Scan(src, &g); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+//==============================================
+// Standalone Functions
+//==============================================
+
+// Register registers the guid types with tm. Register must be called before tm is used.
+func Register(tm *pgtype.Map) {
+	tm.TryWrapEncodePlanFuncs = append([]pgtype.TryWrapEncodePlanFunc{TryWrapEncodePlan}, tm.TryWrapEncodePlanFuncs...)
+	tm.TryWrapScanPlanFuncs = append([]pgtype.TryWrapScanPlanFunc{TryWrapScanPlan}, tm.TryWrapScanPlanFuncs...)
+
+	tm.RegisterType(&pgtype.Type{
+		Name:  "uuid",
+		OID:   pgtype.UUIDOID,
+		Codec: UUIDCodec{},
+	})
+
+	registerDefaultPgTypeVariants[guid.Guid](tm, "uuid")
+	registerDefaultPgTypeVariants[guid.GuidPG](tm, "uuid")
+	registerDefaultPgTypeVariants[guid.GuidSS](tm, "uuid")
+	registerDefaultPgTypeVariants[guid.GuidV7](tm, "uuid")
+	registerDefaultPgTypeVariants[guid.NullGuid](tm, "uuid")
+	registerDefaultPgTypeVariants[guid.NullGuidPG](tm, "uuid")
+	registerDefaultPgTypeVariants[guid.NullGuidSS](tm, "uuid")
+}
+
+// TryWrapEncodePlan is a pgtype.TryWrapEncodePlanFunc that wraps guid values as pgtype.UUIDValuer.
+func TryWrapEncodePlan(value any) (plan pgtype.WrappedEncodePlanNextSetter, nextValue any, ok bool) {
+	if next := wrapValue(value); next != nil {
+		return &wrapEncodePlan{}, next, true
+	}
+	return nil, nil, false
+}
+
+// TryWrapScanPlan is a pgtype.TryWrapScanPlanFunc that wraps guid scan targets as pgtype.UUIDScanner.
+func TryWrapScanPlan(target any) (plan pgtype.WrappedScanPlanNextSetter, nextDst any, ok bool) {
+	if next := wrapTarget(target); next != nil {
+		return &wrapScanPlan{}, next, true
+	}
+	return nil, nil, false
+}
+
+// wrapValue returns the pgtype.UUIDValuer for a guid value, or nil for other values.
+func wrapValue(value any) pgtype.UUIDValuer {
+	switch value := value.(type) {
+	case guid.Guid:
+		return UUID(value)
+	case guid.GuidPG:
+		return UUID(value.Guid)
+	case guid.GuidSS:
+		return UUID(value.Guid.ToMicrosoftBytes())
+	case guid.GuidV7:
+		return UUID(value.Guid)
+	case guid.NullGuid:
+		return NullUUID(value)
+	case guid.NullGuidPG:
+		return NullUUID{Guid: value.GuidPG.Guid, Valid: value.Valid}
+	case guid.NullGuidSS:
+		return NullUUID{Guid: value.GuidSS.Guid.ToMicrosoftBytes(), Valid: value.Valid}
+	}
+	return nil
+}
+
+// wrapTarget returns the pgtype.UUIDScanner for a guid scan target, or nil for other targets.
+func wrapTarget(target any) pgtype.UUIDScanner {
+	switch target := target.(type) {
+	case *guid.Guid:
+		return (*UUID)(target)
+	case *guid.GuidPG:
+		return (*UUID)(&target.Guid)
+	case *guid.GuidSS:
+		return uuidTarget{guid: &target.Guid, microsoft: true}
+	case *guid.GuidV7:
+		return (*UUID)(&target.Guid)
+	case *guid.NullGuid:
+		return (*NullUUID)(target)
+	case *guid.NullGuidPG:
+		return uuidTarget{guid: &target.GuidPG.Guid, valid: &target.Valid}
+	case *guid.NullGuidSS:
+		return uuidTarget{guid: &target.GuidSS.Guid, valid: &target.Valid, microsoft: true}
+	}
+	return nil
+}
+
+type wrapEncodePlan struct {
+	next pgtype.EncodePlan
+}
+
+func (plan *wrapEncodePlan) SetNext(next pgtype.EncodePlan) { plan.next = next }
+
+func (plan *wrapEncodePlan) Encode(value any, buf []byte) (newBuf []byte, err error) {
+	return plan.next.Encode(wrapValue(value), buf)
+}
+
+type wrapScanPlan struct {
+	next pgtype.ScanPlan
+}
+
+func (plan *wrapScanPlan) SetNext(next pgtype.ScanPlan) { plan.next = next }
+
+func (plan *wrapScanPlan) Scan(src []byte, dst any) error {
+	return plan.next.Scan(src, wrapTarget(dst))
+}
+
+// registerDefaultPgTypeVariants maps T, *T, []T, *[]T, []*T, *[]*T,
+// pgtype.FlatArray[T] and pgtype.FlatArray[*T] to the named type and its array type.
+func registerDefaultPgTypeVariants[T any](tm *pgtype.Map, name string) {
+	arrayName := "_" + name
+
+	var value T
+	tm.RegisterDefaultPgType(value, name)
+	tm.RegisterDefaultPgType(&value, name)
+
+	var sliceT []T
+	tm.RegisterDefaultPgType(sliceT, arrayName)
+	tm.RegisterDefaultPgType(&sliceT, arrayName)
+
+	var slicePtrT []*T
+	tm.RegisterDefaultPgType(slicePtrT, arrayName)
+	tm.RegisterDefaultPgType(&slicePtrT, arrayName)
+
+	var arrayOfT pgtype.FlatArray[T]
+	tm.RegisterDefaultPgType(arrayOfT, arrayName)
+	tm.RegisterDefaultPgType(&arrayOfT, arrayName)
+
+	var arrayOfPtrT pgtype.FlatArray[*T]
+	tm.RegisterDefaultPgType(arrayOfPtrT, arrayName)
+	tm.RegisterDefaultPgType(&arrayOfPtrT, arrayName)
+}
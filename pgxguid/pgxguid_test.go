@@ -0,0 +1,165 @@
+package pgxguid
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/sdrapkin/guid"
+)
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	Register(m)
+	return m
+}
+
+func TestEncodeScan(t *testing.T) {
+	m := newMap()
+	g := guid.New()
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		values := []any{g, guid.GuidPG{Guid: g}, guid.GuidV7{Guid: g},
+			guid.NullGuid{Guid: g, Valid: true}, guid.NullGuidPG{GuidPG: guid.GuidPG{Guid: g}, Valid: true}}
+		for _, value := range values {
+			buf, err := m.Encode(pgtype.UUIDOID, format, value, nil)
+			if err != nil {
+				t.Fatalf("format %d: Encode(%T) failed: %v", format, value, err)
+			}
+			if format == pgtype.BinaryFormatCode && string(buf) != string(g[:]) {
+				t.Fatalf("Encode(%T) = %x, want raw bytes %x", value, buf, g[:])
+			}
+			if format == pgtype.TextFormatCode && string(buf) != g.CanonicalString() {
+				t.Fatalf("Encode(%T) = %q, want %q", value, buf, g.CanonicalString())
+			}
+
+			var dst guid.Guid
+			var dstPG guid.GuidPG
+			var dstNull guid.NullGuid
+			var dstNullPG guid.NullGuidPG
+			for _, target := range []any{&dst, &dstPG, &dstNull, &dstNullPG} {
+				if err := m.Scan(pgtype.UUIDOID, format, buf, target); err != nil {
+					t.Fatalf("format %d: Scan(%T) failed: %v", format, target, err)
+				}
+			}
+			if dst != g || dstPG.Guid != g || !dstNull.Valid || dstNull.Guid != g ||
+				!dstNullPG.Valid || dstNullPG.GuidPG.Guid != g {
+				t.Fatalf("format %d: scanned values do not match %v", format, g)
+			}
+		}
+	}
+}
+
+// TestRegisterMatchesDefault checks that Register encodes and scans GuidSS and NullGuidSS
+// like pgx does without Register (through driver.Valuer and sql.Scanner), in the byte order of GuidSS.Value.
+func TestRegisterMatchesDefault(t *testing.T) {
+	m, plain := newMap(), pgtype.NewMap()
+	g := guid.New()
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		for _, value := range []any{guid.GuidSS{Guid: g}, guid.NullGuidSS{GuidSS: guid.GuidSS{Guid: g}, Valid: true}} {
+			buf, err := m.Encode(pgtype.UUIDOID, format, value, nil)
+			if err != nil {
+				t.Fatalf("format %d: Encode(%T) failed: %v", format, value, err)
+			}
+			want, err := plain.Encode(pgtype.UUIDOID, format, value, nil)
+			if err != nil {
+				t.Fatalf("format %d: default Encode(%T) failed: %v", format, value, err)
+			}
+			if string(buf) != string(want) {
+				t.Fatalf("format %d: Encode(%T) = %x, default encoding %x", format, value, buf, want)
+			}
+
+			target := reflect.New(reflect.TypeOf(value))
+			if err := m.Scan(pgtype.UUIDOID, format, buf, target.Interface()); err != nil {
+				t.Fatalf("format %d: Scan(%T) failed: %v", format, target.Interface(), err)
+			}
+			if got := target.Elem().Interface(); got != value {
+				t.Fatalf("format %d: Scan(%T) = %v, want %v", format, target.Interface(), got, value)
+			}
+			target = reflect.New(reflect.TypeOf(value))
+			if err := plain.Scan(pgtype.UUIDOID, format, buf, target.Interface()); err != nil {
+				t.Fatalf("format %d: default Scan(%T) failed: %v", format, target.Interface(), err)
+			}
+			if got := target.Elem().Interface(); got != value {
+				t.Fatalf("format %d: default Scan(%T) = %v, want %v", format, target.Interface(), got, value)
+			}
+		}
+	}
+
+	gss := guid.GuidSS{Guid: g}
+	buf, _ := m.Encode(pgtype.UUIDOID, pgtype.TextFormatCode, gss, nil)
+	if string(buf) != gss.SQLServerString() {
+		t.Fatalf("Encode(GuidSS) = %s, want %s", buf, gss.SQLServerString())
+	}
+	var dst guid.GuidSS
+	if err := m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, nil, &dst); err == nil {
+		t.Fatal("Scan(NULL) into guid.GuidSS should fail")
+	}
+}
+
+func TestNull(t *testing.T) {
+	m := newMap()
+
+	buf, err := m.Encode(pgtype.UUIDOID, pgtype.BinaryFormatCode, guid.NullGuid{}, nil)
+	if err != nil || buf != nil {
+		t.Fatalf("Encode(NullGuid{}) = %v, %v; want nil, nil", buf, err)
+	}
+
+	dst := guid.NullGuid{Guid: guid.New(), Valid: true}
+	if err := m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, nil, &dst); err != nil {
+		t.Fatalf("Scan(NULL) failed: %v", err)
+	}
+	if dst.Valid || dst.Guid != guid.Nil {
+		t.Fatalf("Scan(NULL) = %+v, want zero NullGuid", dst)
+	}
+
+	var g guid.Guid
+	if err := m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, nil, &g); err == nil {
+		t.Fatal("Scan(NULL) into guid.Guid should fail")
+	}
+}
+
+func TestArrays(t *testing.T) {
+	m := newMap()
+	src := []guid.Guid{guid.New(), guid.New(), guid.New()}
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		buf, err := m.Encode(pgtype.UUIDArrayOID, format, src, nil)
+		if err != nil {
+			t.Fatalf("format %d: Encode([]guid.Guid) failed: %v", format, err)
+		}
+		var dst []guid.GuidPG
+		if err := m.Scan(pgtype.UUIDArrayOID, format, buf, &dst); err != nil {
+			t.Fatalf("format %d: Scan([]guid.GuidPG) failed: %v", format, err)
+		}
+		if len(dst) != len(src) {
+			t.Fatalf("format %d: scanned %d elements, want %d", format, len(dst), len(src))
+		}
+		for i := range src {
+			if dst[i].Guid != src[i] {
+				t.Fatalf("format %d: element %d = %v, want %v", format, i, dst[i].Guid, src[i])
+			}
+		}
+	}
+}
+
+func TestDefaultTypeAndDecodeValue(t *testing.T) {
+	m := newMap()
+	g := guid.New()
+
+	for _, value := range []any{g, guid.GuidPG{}, guid.GuidSS{}, guid.NullGuid{}, []guid.Guid{}} {
+		if _, ok := m.TypeForValue(value); !ok {
+			t.Fatalf("TypeForValue(%T) not registered", value)
+		}
+	}
+
+	typ, _ := m.TypeForOID(pgtype.UUIDOID)
+	decoded, err := typ.Codec.DecodeValue(m, pgtype.UUIDOID, pgtype.BinaryFormatCode, g[:])
+	if err != nil {
+		t.Fatalf("DecodeValue failed: %v", err)
+	}
+	if decoded != g {
+		t.Fatalf("DecodeValue = %#v, want guid.Guid %v", decoded, g)
+	}
+}
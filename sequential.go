@@ -0,0 +1,159 @@
+package guid
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Clock is a source of the current time for sequential Guid generation.
+// Implementations must be safe for concurrent use.
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+// Now returns time.Now().
+func (systemClock) Now() time.Time { return time.Now() }
+
+// SequentialGenerator generates sequential Guids with timestamps taken from its Clock.
+// Each SequentialGenerator keeps its own monotonic state. It is safe for concurrent use.
+// The zero value is ready to use, with the system clock.
+type SequentialGenerator struct {
+	clock     Clock
+	timestamp monotonicTimestamp
+	v7State   monotonicV7State
+}
+
+// monotonicTimestamp issues strictly increasing nanosecond timestamps. It is safe for concurrent use.
+type monotonicTimestamp struct {
+	last atomic.Int64
+}
+
+// sequentialTimestamp is shared by NewPGMonotonic and NewSSMonotonic.
+var sequentialTimestamp monotonicTimestamp
+
+// next returns now, or the last issued timestamp + 1ns if now is not greater than it (lock-free).
+func (m *monotonicTimestamp) next(now int64) int64 {
+	for {
+		last := m.last.Load()
+		next := max(now, last+1)
+		if m.last.CompareAndSwap(last, next) {
+			return next
+		}
+	}
+}
+
+//==============================================
+// Standalone Functions
+//==============================================
+
+// NewPGMonotonic generates a new PostgreSQL sortable Guid like NewPG, but strictly increasing across
+// all goroutines of the process: if time.Now() does not advance (coarse clock precision) or goes backwards,
+// the timestamp of the previous Guid + 1ns is used instead.
+func NewPGMonotonic() GuidPG {
+	return newPG(sequentialTimestamp.next(time.Now().UnixNano()))
+}
+
+// NewSSMonotonic generates a new SQL Server sortable Guid like NewSS, but strictly increasing (in SQL Server
+// uniqueidentifier order) across all goroutines of the process: if time.Now() does not advance or goes backwards,
+// the timestamp of the previous Guid + 1ns is used instead.
+func NewSSMonotonic() GuidSS {
+	return newSS(sequentialTimestamp.next(time.Now().UnixNano()))
+}
+
+// SystemClock returns the Clock backed by time.Now(), used by NewSequentialGenerator(nil).
+// The package-level sequential constructors (NewPG, NewSS, NewV7, etc.) read time.Now() directly.
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+// NewSequentialGenerator returns a SequentialGenerator that reads the time from clock.
+// A nil clock means SystemClock().
+func NewSequentialGenerator(clock Clock) *SequentialGenerator {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &SequentialGenerator{clock: clock}
+}
+
+// MinPGForTime returns the smallest GuidPG with timestamp t: [8-byte t timestamp][8 zero bytes].
+func MinPGForTime(t time.Time) (gpg GuidPG) {
+	gpg.setTimestamp(t.UnixNano())
+	return
+}
+
+// MaxPGForTime returns the largest GuidPG with timestamp t: [8-byte t timestamp][8 0xFF bytes].
+func MaxPGForTime(t time.Time) GuidPG {
+	gpg := GuidPG{Guid: Max}
+	gpg.setTimestamp(t.UnixNano())
+	return gpg
+}
+
+// MinSSForTime returns the smallest GuidSS (in SQL Server uniqueidentifier order) with timestamp t:
+// [8 zero bytes][8 bytes of SQL Server ordered t timestamp].
+func MinSSForTime(t time.Time) (gss GuidSS) {
+	gss.setTimestamp(t.UnixNano())
+	return
+}
+
+// MaxSSForTime returns the largest GuidSS (in SQL Server uniqueidentifier order) with timestamp t:
+// [8 0xFF bytes][8 bytes of SQL Server ordered t timestamp].
+func MaxSSForTime(t time.Time) GuidSS {
+	gss := GuidSS{Guid: Max}
+	gss.setTimestamp(t.UnixNano())
+	return gss
+}
+
+// PGRange returns the inclusive GuidPG bounds of all Guids created between from and to (inclusive),
+// for use as "WHERE id BETWEEN $1 AND $2" range scans.
+// from and to must be within the 1970-2262 range of NewPGAt: if from is before 1970 and to is not,
+// lo sorts after hi and the range matches nothing.
+func PGRange(from, to time.Time) (lo, hi GuidPG) {
+	return MinPGForTime(from), MaxPGForTime(to)
+}
+
+// SSRange returns the inclusive GuidSS bounds of all Guids created between from and to (inclusive),
+// for use as "WHERE id BETWEEN @p1 AND @p2" range scans.
+// from and to must be within the 1970-2262 range of NewSSAt: if from is before 1970 and to is not,
+// lo sorts after hi and the range matches nothing.
+func SSRange(from, to time.Time) (lo, hi GuidSS) {
+	return MinSSForTime(from), MaxSSForTime(to)
+}
+
+//==============================================
+// SequentialGenerator Extension Methods
+//==============================================
+
+// NewPG generates a new PostgreSQL sortable Guid like the package-level NewPG, using the generator's Clock.
+func (s *SequentialGenerator) NewPG() GuidPG {
+	return newPG(s.now())
+}
+
+// NewSS generates a new SQL Server sortable Guid like the package-level NewSS, using the generator's Clock.
+func (s *SequentialGenerator) NewSS() GuidSS {
+	return newSS(s.now())
+}
+
+// NewPGMonotonic generates a new PostgreSQL sortable Guid, strictly increasing across all callers of the generator.
+func (s *SequentialGenerator) NewPGMonotonic() GuidPG {
+	return newPG(s.timestamp.next(s.now()))
+}
+
+// NewSSMonotonic generates a new SQL Server sortable Guid, strictly increasing across all callers of the generator.
+func (s *SequentialGenerator) NewSSMonotonic() GuidSS {
+	return newSS(s.timestamp.next(s.now()))
+}
+
+// NewV7 generates a new version 7 Guid, strictly increasing across all callers of the generator.
+func (s *SequentialGenerator) NewV7() GuidV7 {
+	return newV7(s.v7State.next(s.now()))
+}
+
+// now returns the Clock time in Unix nanoseconds, or time.Now() for a zero-value SequentialGenerator.
+func (s *SequentialGenerator) now() int64 {
+	if s.clock == nil {
+		return time.Now().UnixNano()
+	}
+	return s.clock.Now().UnixNano()
+}
@@ -0,0 +1,113 @@
+package guid
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// sortableAlphabet is the Base64Url alphabet in ASCII order, so that encoded strings sort like the encoded bytes.
+const sortableAlphabet = "-0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ_abcdefghijklmnopqrstuvwxyz"
+
+// ErrInvalidSortableGuidEncoding is returned when a string does not represent a valid sortable Guid encoding.
+var ErrInvalidSortableGuidEncoding = errors.New("invalid sortable Guid encoding (invalid characters, or length != 22)")
+
+//==============================================
+// Guid Extension Methods
+//==============================================
+
+// SortableString returns the 22-char order-preserving representation of the Guid: Base64Url bit packing
+// with the Base64Url characters in ASCII order ("-0-9A-Z_a-z"). Unlike String(), the strings compare
+// (bytewise, e.g. in Redis, S3 object names or DynamoDB sort keys) in the same order as the Guid bytes,
+// so the strings of GuidPG values sort in creation order.
+// The form is not Base64Url: parse it with ParseSortable, not Parse.
+func (guid *Guid) SortableString() string {
+	buffer := make([]byte, GuidBase64UrlByteSize)
+	guid.encodeBase64(buffer, sortableAlphabet)
+	return unsafe.String(&buffer[0], GuidBase64UrlByteSize) // same approach as String()
+}
+
+// EncodeSortable encodes the Guid into the provided dst as the 22-char order-preserving form (see SortableString).
+func (guid *Guid) EncodeSortable(dst []byte) error {
+	if len(dst) < GuidBase64UrlByteSize {
+		return ErrBufferTooSmallBase64Url
+	}
+	guid.encodeBase64(dst, sortableAlphabet)
+	return nil
+}
+
+//==============================================
+// Standalone Functions
+//==============================================
+
+// ParseSortable parses a 22-char order-preserving string (see Guid.SortableString) into a Guid.
+func ParseSortable(s string) (g Guid, err error) {
+	if len(s) != GuidBase64UrlByteSize {
+		return Guid{}, ErrInvalidSortableGuidEncoding
+	}
+
+	// Zero-copy conversion of a string to a byte slice
+	sBytes := unsafe.Slice(unsafe.StringData(s), GuidBase64UrlByteSize)
+
+	if ok := DecodeSortable(g[:], sBytes); !ok {
+		return Guid{}, ErrInvalidSortableGuidEncoding
+	}
+	return g, nil
+}
+
+// ParseSortableBytes parses a 22-char order-preserving string represented as a byte slice into a Guid.
+// ParseSortableBytes is like ParseSortable, except it parses a string byte slice instead of a string.
+func ParseSortableBytes(src []byte) (g Guid, err error) {
+	if len(src) != GuidBase64UrlByteSize {
+		return Guid{}, ErrInvalidSortableGuidEncoding
+	}
+
+	if ok := DecodeSortable(g[:], src); !ok {
+		return Guid{}, ErrInvalidSortableGuidEncoding
+	}
+	return g, nil
+}
+
+// DecodeSortable decodes a 22-char order-preserving src byte slice (see Guid.SortableString) into a Guid dst byte slice.
+// Does not panic on invalid input.
+// dst must be at least 16 bytes long and src must be at least 22 bytes long (returns false otherwise).
+// dst is modified even if the function returns false.
+func DecodeSortable(dst []byte, src []byte) (ok bool) {
+	return decodeBase64(dst, src, &decodeLookupSortable)
+}
+
+// decodeLookupSortable is a lookup table for decoding order-preserving characters ("-0-9A-Z_a-z") to their values.
+// Generated the same way as decodeLookup. Values outside the sortable alphabet are marked with 0xFF.
+var decodeLookupSortable = [256]byte{
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0x00, 0xFF, 0xFF,
+	0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+	0x09, 0x0A, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0x0B, 0x0C, 0x0D, 0x0E, 0x0F, 0x10, 0x11,
+	0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19,
+	0x1A, 0x1B, 0x1C, 0x1D, 0x1E, 0x1F, 0x20, 0x21,
+	0x22, 0x23, 0x24, 0xFF, 0xFF, 0xFF, 0xFF, 0x25,
+	0xFF, 0x26, 0x27, 0x28, 0x29, 0x2A, 0x2B, 0x2C,
+	0x2D, 0x2E, 0x2F, 0x30, 0x31, 0x32, 0x33, 0x34,
+	0x35, 0x36, 0x37, 0x38, 0x39, 0x3A, 0x3B, 0x3C,
+	0x3D, 0x3E, 0x3F, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
+}
@@ -0,0 +1,115 @@
+package guid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"unsafe"
+)
+
+//==============================================
+// Compile-time interface assertions
+//==============================================
+
+var (
+	_ sql.Scanner   = &Guid{}
+	_ driver.Valuer = Guid{}
+	_ sql.Scanner   = &GuidPG{}
+	_ driver.Valuer = GuidPG{}
+	_ sql.Scanner   = &GuidSS{}
+	_ driver.Valuer = GuidSS{}
+)
+
+//==============================================
+// Guid Extension Methods
+//==============================================
+
+// Scan implements the sql.Scanner interface.
+// It accepts nil (scanned as Nil), 16 raw bytes, and the 22-char Base64Url or 36-char canonical
+// hyphenated text forms (as either string or []byte).
+func (guid *Guid) Scan(src any) error {
+	switch src := src.(type) {
+	case nil:
+		*guid = Guid{}
+		return nil
+	case []byte:
+		if len(src) == GuidByteSize {
+			copy(guid[:], src)
+			return nil
+		}
+		return guid.scanText(src)
+	case string:
+		// Zero-copy conversion of a string to a byte slice
+		return guid.scanText(unsafe.Slice(unsafe.StringData(src), len(src)))
+	}
+	return fmt.Errorf("guid: cannot scan type %T into a Guid", src)
+}
+
+// scanText decodes a Base64Url or canonical text Guid. guid is left unchanged on error.
+func (guid *Guid) scanText(src []byte) error {
+	var g Guid
+	var ok bool
+	switch len(src) {
+	case GuidBase64UrlByteSize:
+		ok = DecodeBase64URL(g[:], src)
+	case GuidCanonicalByteSize:
+		ok = DecodeCanonical(g[:], src)
+	}
+	if !ok {
+		return fmt.Errorf("guid: cannot scan %q into a Guid", src)
+	}
+	*guid = g
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the 16 raw bytes of the Guid, suitable for BINARY(16), BLOB and bytea columns.
+func (guid Guid) Value() (driver.Value, error) {
+	return guid[:], nil
+}
+
+//==============================================
+// GuidPG Extension Methods
+//==============================================
+
+// Value implements the driver.Valuer interface.
+// It returns the canonical hyphenated string expected by the PostgreSQL uuid type.
+func (g GuidPG) Value() (driver.Value, error) {
+	return g.CanonicalString(), nil
+}
+
+//==============================================
+// GuidSS Extension Methods
+//==============================================
+
+// Scan implements the sql.Scanner interface.
+// 16 raw bytes are the uniqueidentifier storage bytes returned by SQL Server drivers, and are copied as is.
+// 36-char canonical text is SQL Server's display form (see SQLServerString), and is byte-swapped accordingly.
+// nil and 22-char Base64Url text are handled like Guid.Scan.
+func (g *GuidSS) Scan(src any) error {
+	var text []byte
+	switch src := src.(type) {
+	case []byte:
+		text = src
+	case string:
+		// Zero-copy conversion of a string to a byte slice
+		text = unsafe.Slice(unsafe.StringData(src), len(src))
+	}
+	if len(text) != GuidCanonicalByteSize {
+		return g.Guid.Scan(src)
+	}
+
+	gss, err := parseSQLServerString(text)
+	if err != nil {
+		return fmt.Errorf("guid: cannot scan %q into a GuidSS", text)
+	}
+	*g = gss
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// It returns the uniqueidentifier text SQL Server displays for the GuidSS (see SQLServerString),
+// so that SQL Server stores exactly the GuidSS bytes and keeps their sort order.
+func (g GuidSS) Value() (driver.Value, error) {
+	return g.SQLServerString(), nil
+}
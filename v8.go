@@ -0,0 +1,217 @@
+package guid
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidV8Layout is returned when a V8Layout field is unnamed, duplicated, out of range,
+	// or overlaps another field or the version/variant bits.
+	ErrInvalidV8Layout = errors.New("invalid V8 layout")
+	// ErrUnknownV8Field is returned when a field name is not part of the V8Layout.
+	ErrUnknownV8Field = errors.New("unknown V8 field")
+	// ErrV8FieldOverflow is returned when a value does not fit into the width of its V8Layout field.
+	ErrV8FieldOverflow = errors.New("value overflows V8 field width")
+)
+
+// errNoV8Layout is returned by a V8Builder that was not created by V8Layout.NewBuilder.
+var errNoV8Layout = fmt.Errorf("%w: V8Builder has no layout, use V8Layout.NewBuilder", ErrInvalidV8Layout)
+
+// Reserved bit ranges of a version 8 Guid, numbered from the most significant bit of byte 0.
+const (
+	v8VersionOffset = 48 // 4 version bits: 48-51
+	v8VersionWidth  = 4
+	v8VariantOffset = 64 // 2 variant bits: 64-65
+	v8VariantWidth  = 2
+)
+
+// V8Field describes one custom field of a version 8 Guid.
+// Offset is the bit offset from the most significant bit of byte 0 (the RFC 9562 bit numbering),
+// and Width is the field width in bits (1-64).
+type V8Field struct {
+	Name   string
+	Offset uint8
+	Width  uint8
+}
+
+// V8Layout is a validated set of non-overlapping V8Fields that packs into and unpacks from a version 8 Guid.
+// A V8Layout is immutable and safe for concurrent use.
+type V8Layout struct {
+	fields []V8Field
+}
+
+// V8Builder packs field values into a version 8 Guid. Bits not covered by a Set field are random,
+// drawn anew by every Build, so one V8Builder can build many Guids that share the Set field values.
+// A V8Builder must be created by V8Layout.NewBuilder: a zero V8Builder returns ErrInvalidV8Layout.
+// A V8Builder is not safe for concurrent use.
+type V8Builder struct {
+	layout       *V8Layout
+	bits         Guid   // values of the Set fields
+	setHi, setLo uint64 // mask of the Set field bits
+	err          error
+}
+
+//==============================================
+// V8Layout Extension Methods
+//==============================================
+
+// NewV8Layout validates fields and returns a V8Layout.
+// Fields must have unique non-empty names, a width of 1-64 bits, fit within 128 bits,
+// and must not overlap each other or the version (48-51) and variant (64-65) bits.
+func NewV8Layout(fields ...V8Field) (*V8Layout, error) {
+	var usedHi, usedLo uint64
+	usedHi, usedLo = orMask128(usedHi, usedLo, v8VersionOffset, v8VersionWidth)
+	usedHi, usedLo = orMask128(usedHi, usedLo, v8VariantOffset, v8VariantWidth)
+	names := make(map[string]struct{}, len(fields))
+
+	for _, f := range fields {
+		switch _, duplicate := names[f.Name]; {
+		case f.Name == "":
+			return nil, fmt.Errorf("%w: field at offset %d has no name", ErrInvalidV8Layout, f.Offset)
+		case duplicate:
+			return nil, fmt.Errorf("%w: duplicate field %q", ErrInvalidV8Layout, f.Name)
+		case f.Width == 0 || f.Width > 64:
+			return nil, fmt.Errorf("%w: field %q width %d is not within 1-64", ErrInvalidV8Layout, f.Name, f.Width)
+		case int(f.Offset)+int(f.Width) > GuidByteSize*8:
+			return nil, fmt.Errorf("%w: field %q exceeds 128 bits", ErrInvalidV8Layout, f.Name)
+		}
+		hi, lo := orMask128(0, 0, f.Offset, f.Width)
+		if hi&usedHi != 0 || lo&usedLo != 0 {
+			return nil, fmt.Errorf("%w: field %q overlaps another field or the version/variant bits", ErrInvalidV8Layout, f.Name)
+		}
+		usedHi, usedLo = usedHi|hi, usedLo|lo
+		names[f.Name] = struct{}{}
+	}
+	return &V8Layout{fields: append([]V8Field(nil), fields...)}, nil
+}
+
+// Fields returns a copy of the fields of the layout.
+func (l *V8Layout) Fields() []V8Field {
+	return append([]V8Field(nil), l.fields...)
+}
+
+// NewBuilder returns a V8Builder for the layout, with no fields set.
+func (l *V8Layout) NewBuilder() *V8Builder {
+	return &V8Builder{layout: l}
+}
+
+// Get unpacks the value of the named field from g.
+func (l *V8Layout) Get(g Guid, name string) (uint64, error) {
+	f, ok := l.field(name)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownV8Field, name)
+	}
+	hi, lo := g.halves()
+	_, value := shr128(hi, lo, fieldShift(f))
+	return value & widthMask(f.Width), nil
+}
+
+func (l *V8Layout) field(name string) (V8Field, bool) {
+	for _, f := range l.fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return V8Field{}, false
+}
+
+//==============================================
+// V8Builder Extension Methods
+//==============================================
+
+// Set packs value into the named field. The first error is kept and returned by Build.
+func (b *V8Builder) Set(name string, value uint64) *V8Builder {
+	if b.err != nil {
+		return b
+	}
+	if b.layout == nil {
+		b.err = errNoV8Layout
+		return b
+	}
+	f, ok := b.layout.field(name)
+	switch {
+	case !ok:
+		b.err = fmt.Errorf("%w: %q", ErrUnknownV8Field, name)
+	case value&^widthMask(f.Width) != 0:
+		b.err = fmt.Errorf("%w: %q is %d bits wide", ErrV8FieldOverflow, name, f.Width)
+	default:
+		hi, lo := b.bits.halves()
+		maskHi, maskLo := orMask128(0, 0, f.Offset, f.Width)
+		valueHi, valueLo := shl128(0, value, fieldShift(f))
+		b.bits = fromHalves(hi&^maskHi|valueHi, lo&^maskLo|valueLo)
+		b.setHi, b.setLo = b.setHi|maskHi, b.setLo|maskLo
+	}
+	return b
+}
+
+// Build returns a version 8 Guid with the Set field values and fresh cryptographically secure random bits
+// everywhere else, or the first error encountered by Set.
+func (b *V8Builder) Build() (Guid, error) {
+	if b.err != nil {
+		return Guid{}, b.err
+	}
+	if b.layout == nil {
+		return Guid{}, errNoV8Layout
+	}
+	random := New()
+	randomHi, randomLo := random.halves()
+	hi, lo := b.bits.halves()
+	return NewV8(fromHalves(randomHi&^b.setHi|hi, randomLo&^b.setLo|lo)), nil
+}
+
+//==============================================
+// Standalone Functions
+//==============================================
+
+// NewV8 returns an RFC 9562 version 8 (custom) Guid: the caller-provided bits,
+// with the version bits (48-51) and variant bits (64-65) overwritten.
+func NewV8(custom [16]byte) Guid {
+	g := Guid(custom)
+	g.setVersionAndVariant(8)
+	return g
+}
+
+//==============================================
+// 128-bit helpers
+//==============================================
+
+func (guid *Guid) halves() (hi, lo uint64) {
+	return binary.BigEndian.Uint64(guid[:8]), binary.BigEndian.Uint64(guid[8:])
+}
+
+func fromHalves(hi, lo uint64) (g Guid) {
+	binary.BigEndian.PutUint64(g[:8], hi)
+	binary.BigEndian.PutUint64(g[8:], lo)
+	return
+}
+
+// fieldShift returns the distance between the least significant bit of the field and bit 127.
+func fieldShift(f V8Field) uint {
+	return uint(GuidByteSize*8 - int(f.Offset) - int(f.Width))
+}
+
+func widthMask(width uint8) uint64 {
+	return ^uint64(0) >> (64 - width)
+}
+
+// orMask128 sets the bits [offset, offset+width) of the 128-bit value hi:lo.
+func orMask128(hi, lo uint64, offset, width uint8) (uint64, uint64) {
+	maskHi, maskLo := shl128(0, widthMask(width), fieldShift(V8Field{Offset: offset, Width: width}))
+	return hi | maskHi, lo | maskLo
+}
+
+func shl128(hi, lo uint64, s uint) (uint64, uint64) {
+	if s >= 64 {
+		return lo << (s - 64), 0
+	}
+	return hi<<s | lo>>(64-s), lo << s
+}
+
+func shr128(hi, lo uint64, s uint) (uint64, uint64) {
+	if s >= 64 {
+		return 0, hi >> (s - 64)
+	}
+	return hi >> s, lo>>s | hi<<(64-s)
+}
@@ -0,0 +1,77 @@
+package guid
+
+import "fmt"
+
+// Variant is the RFC 9562 variant field of a Guid (the most significant bits of byte 8).
+type Variant uint8
+
+const (
+	VariantNCS       Variant = iota // 0xx: reserved, NCS backward compatibility
+	VariantRFC9562                  // 10x: RFC 9562 (formerly RFC 4122)
+	VariantMicrosoft                // 110: reserved, Microsoft backward compatibility
+	VariantFuture                   // 111: reserved for future definition
+)
+
+var variantNames = [...]string{
+	VariantNCS:       "NCS",
+	VariantRFC9562:   "RFC9562",
+	VariantMicrosoft: "Microsoft",
+	VariantFuture:    "Future",
+}
+
+// String returns the name of the Variant.
+func (v Variant) String() string {
+	if int(v) < len(variantNames) {
+		return variantNames[v]
+	}
+	return fmt.Sprintf("Variant(%d)", uint8(v))
+}
+
+//==============================================
+// Guid Extension Methods
+//==============================================
+
+// Version returns the RFC 9562 version field of the Guid (the high nibble of byte 6).
+// The value is only meaningful when Variant() is VariantRFC9562.
+func (guid Guid) Version() int {
+	return int(guid[6] >> 4)
+}
+
+// Variant returns the RFC 9562 variant field of the Guid.
+func (guid Guid) Variant() Variant {
+	switch b := guid[8]; {
+	case b&0x80 == 0x00:
+		return VariantNCS
+	case b&0xC0 == 0x80:
+		return VariantRFC9562
+	case b&0xE0 == 0xC0:
+		return VariantMicrosoft
+	default:
+		return VariantFuture
+	}
+}
+
+// IsRFC9562 reports whether the Guid has the RFC 9562 variant and one of the defined versions (1-8).
+// The special Nil and Max values return false.
+func (guid Guid) IsRFC9562() bool {
+	version := guid.Version()
+	return guid.Variant() == VariantRFC9562 && version >= 1 && version <= 8
+}
+
+// private - stamps the version nibble and the RFC 9562 variant bits, keeping all other bits.
+func (guid *Guid) setVersionAndVariant(version byte) {
+	guid[6] = (guid[6] & 0x0F) | (version << 4)
+	guid[8] = (guid[8] & 0x3F) | 0x80
+}
+
+//==============================================
+// Standalone Functions
+//==============================================
+
+// NewV4 generates a new cryptographically secure RFC 9562 version 4 (random) Guid.
+// It is like New(), but with the version and variant bits set (122 random bits instead of 128).
+func NewV4() (g Guid) {
+	g = New()
+	g.setVersionAndVariant(4)
+	return
+}